@@ -2,7 +2,9 @@ package whmcsgo
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 )
@@ -12,18 +14,38 @@ CreateInvoice Create an invoice using the provided parameters.
 
 WHMCS API docs
 
-https://developers.whmcs.com/api-reference/getclients/
+https://developers.whmcs.com/api-reference/createinvoice/
 
 Request Parameters
 
+userid
+	int	The ID of the client to charge	Required
+status
+	string	The status of the invoice being created (Defaults to Unpaid)	Optional
 draft
 	bool	Should the invoice be created in draft status (No need to pass $status also)	Optional
+sendinvoice
+	bool	Should the Invoice Created Email be sent to the client	Optional
 paymentmethod
 	string	The payment method of the created invoice in system format	Optional
 taxrate
 	float	The first level tax rate to apply to the invoice to override the system default	Optional
 taxrate2
 	float	The second level tax rate to apply to the invoice to override the system default	Optional
+date
+	\Carbon\Carbon	The date that the invoice should show as created YYYY-mm-dd	Optional
+duedate
+	\Carbon\Carbon	The due date of the newly created invoice YYYY-mm-dd	Optional
+notes
+	string	The notes to appear on the created invoice	Optional
+itemdescriptionx
+	string	The line items description. X is an integer to add multiple invoice items	Optional
+itemamountx
+	float	The line items amount	Optional
+itemtaxedx
+	bool	The line items is taxed value	Optional
+autoapplycredit
+	bool	Should credit on the client account be automatically applied to the invoice	Optional
 
 Response Parameters
 
@@ -42,19 +64,13 @@ type InvoiceResponse struct {
 	Status    string
 }
 
-func (s *BillingService) CreateInvoice(userID int, invoice CreateInvoiceRequest) (int, *Response, error) {
-	//a := new(BillingItem)
-
-	userid := fmt.Sprintf("%d", userID)
-	invoiceParams := map[string]string{"userid": userid}
-
-	switch invoice.Status {
-	case "Draft", "Unpaid", "Paid":
-		invoiceParams["status"] = invoice.Status
-	default:
-		return 0, &Response{}, fmt.Errorf("unsupported status value: %s", invoice.Status)
+func (s *BillingService) CreateInvoice(invoice CreateInvoiceRequest) (int, *Response, error) {
+	if err := invoice.Validate(); err != nil {
+		return 0, &Response{}, err
 	}
 
+	invoiceParams := map[string]string{"userid": fmt.Sprintf("%d", invoice.UserID)}
+	invoiceParams["status"] = string(invoice.Status)
 	invoiceParams["sendinvoice"] = FormatBool(invoice.SendInvoice)
 	invoiceParams["autoapplycredit"] = FormatBool(invoice.AutoApplyCredit)
 
@@ -72,8 +88,16 @@ func (s *BillingService) CreateInvoice(userID int, invoice CreateInvoiceRequest)
 		invoiceParams["notes"] = invoice.Notes
 	}
 
-	if len(invoice.LineItems) < 1 {
-		return 0, &Response{}, fmt.Errorf("No Line items for invoice found")
+	if len(invoice.PaymentMethod) > 0 {
+		invoiceParams["paymentmethod"] = invoice.PaymentMethod
+	}
+
+	if taxRate := invoice.taxRate(); taxRate != nil {
+		invoiceParams["taxrate"] = fmt.Sprintf("%.2f", *taxRate)
+	}
+
+	if invoice.TaxRate2 != nil {
+		invoiceParams["taxrate2"] = fmt.Sprintf("%.2f", *invoice.TaxRate2)
 	}
 
 	li := lineItemstoParams(invoice.LineItems)
@@ -99,8 +123,8 @@ func lineItemstoParams(items []InvoiceLineItems) map[string]string {
 	lineItems := map[string]string{}
 	for _, li := range items {
 		lineItems[fmt.Sprintf("itemdescription%d", li.ItemOrder)] = li.ItemDescription
-		lineItems[fmt.Sprintf("itemamount%d", li.ItemOrder)] = fmt.Sprintf("%.2f", li.ItemAmount)
-		lineItems[fmt.Sprintf("itemtaxed%d", li.ItemOrder)] = FormatBool(li.ItemTaxed)
+		lineItems[fmt.Sprintf("itemamount%d", li.ItemOrder)] = fmt.Sprintf("%.2f", li.Amount())
+		lineItems[fmt.Sprintf("itemtaxed%d", li.ItemOrder)] = FormatBool(li.taxed())
 	}
 
 	return lineItems
@@ -108,9 +132,12 @@ func lineItemstoParams(items []InvoiceLineItems) map[string]string {
 
 // CreateInvoiceRequest the new invoice to be created for a client
 type CreateInvoiceRequest struct {
-	// The ID of the client to charge
-	Status          string             // The status of the invoice being created Paid,Unpaid,Draft
+	UserID          int                // The ID of the client to charge
+	Status          InvoiceStatus      // The status of the invoice being created Paid,Unpaid,Draft
 	SendInvoice     bool               // Should the Invoice Created Email be sent to the client
+	PaymentMethod   string             // The payment method of the created invoice in system format
+	TaxRate         *float64           // The first level tax rate, overrides the system default
+	TaxRate2        *float64           // The second level tax rate, overrides the system default
 	Date            time.Time          // The date that the invoice should show as created
 	DueDate         time.Time          // The due date of the newly created invoice
 	Notes           string             // The notes to appear on the created invoice
@@ -118,18 +145,133 @@ type CreateInvoiceRequest struct {
 	LineItems       []InvoiceLineItems // Invoice Line Items
 }
 
+/*
+Validate checks the invoice before it is sent to WHMCS.
+
+The due date can not be before the invoice date, each line item amount must
+match its quantity × unit price and every taxed line must share the same tax
+rate, as WHMCS only applies tax rates to the whole invoice.
+*/
+func (c CreateInvoiceRequest) Validate() error {
+	if c.UserID < 1 {
+		return errors.New("client ID required to create an invoice")
+	}
+
+	switch c.Status {
+	case InvoiceDraft, InvoiceUnpaid, InvoicePaid:
+	default:
+		return fmt.Errorf("unsupported status value: %s", c.Status)
+	}
+
+	if !c.Date.IsZero() && !c.DueDate.IsZero() && c.DueDate.Before(c.Date) {
+		return fmt.Errorf("due date %s is before the invoice date %s", c.DueDate.Format("2006-01-02"), c.Date.Format("2006-01-02"))
+	}
+
+	if len(c.LineItems) < 1 {
+		return fmt.Errorf("No Line items for invoice found")
+	}
+
+	var rate *float64
+	for _, li := range c.LineItems {
+		if err := li.Validate(); err != nil {
+			return err
+		}
+		if li.TaxRate == nil || *li.TaxRate == 0 {
+			continue
+		}
+		if rate != nil && *rate != *li.TaxRate {
+			return fmt.Errorf("line item %d tax rate %.2f differs from %.2f, WHMCS only supports one tax rate per invoice", li.ItemOrder, *li.TaxRate, *rate)
+		}
+		rate = li.TaxRate
+	}
+
+	if rate != nil && c.TaxRate != nil && *rate != *c.TaxRate {
+		return fmt.Errorf("line item tax rate %.2f differs from the invoice tax rate %.2f", *rate, *c.TaxRate)
+	}
+
+	return nil
+}
+
+// taxRate returns the invoice tax rate, falling back to the rate of the taxed line items
+func (c CreateInvoiceRequest) taxRate() *float64 {
+	if c.TaxRate != nil {
+		return c.TaxRate
+	}
+	for _, li := range c.LineItems {
+		if li.TaxRate != nil && *li.TaxRate != 0 {
+			return li.TaxRate
+		}
+	}
+	return nil
+}
+
 // InvoiceLineItems the new invoice to be created for a client
 type InvoiceLineItems struct {
-	ItemOrder       int     // The Order to be show on the invoice
-	ItemDescription string  // The line items description
-	ItemAmount      float32 // The line items amount
-	ItemTaxed       bool    // The line items is taxed value
+	ItemOrder       int      // The Order to be show on the invoice
+	ItemDescription string   // The line items description
+	ItemAmount      float32  // The line items amount, calculated from the quantity and unit price when not set
+	ItemTaxed       bool     // The line items is taxed value
+	Quantity        float64  // The quantity of the unit price, 1 when not set
+	UnitPrice       float64  // The price of a single unit
+	TaxRate         *float64 // The tax rate of the line item, a rate above zero marks the line as taxed
+}
+
+// priced reports whether the amount comes from the quantity and unit price
+func (li InvoiceLineItems) priced() bool {
+	return li.Quantity != 0 || li.UnitPrice != 0
+}
+
+// quantity returns the quantity of the line item, defaulting to 1
+func (li InvoiceLineItems) quantity() float64 {
+	if li.Quantity == 0 {
+		return 1
+	}
+	return li.Quantity
+}
+
+// unitPrice returns the unit price, the amount is used when the line is not priced
+func (li InvoiceLineItems) unitPrice() float64 {
+	if !li.priced() {
+		return roundCents(float64(li.ItemAmount))
+	}
+	return li.UnitPrice
+}
+
+// Amount returns the amount of the line item, quantity × unit price when those are set
+func (li InvoiceLineItems) Amount() float64 {
+	if !li.priced() || li.ItemAmount != 0 {
+		return roundCents(float64(li.ItemAmount))
+	}
+	return roundCents(li.quantity() * li.UnitPrice)
+}
+
+// Validate checks the amount matches quantity × unit price when both are given
+func (li InvoiceLineItems) Validate() error {
+	if li.TaxRate != nil && *li.TaxRate < 0 {
+		return fmt.Errorf("line item %d has a negative tax rate", li.ItemOrder)
+	}
+	if !li.priced() || li.ItemAmount == 0 {
+		return nil
+	}
+
+	want := roundCents(li.quantity() * li.UnitPrice)
+	if got := roundCents(float64(li.ItemAmount)); math.Abs(got-want) > 0.005 {
+		return fmt.Errorf("line item %d amount %.2f does not match quantity × unit price %.2f", li.ItemOrder, got, want)
+	}
+	return nil
+}
 
+// taxed returns whether the line is taxed, a tax rate takes precedence over ItemTaxed
+func (li InvoiceLineItems) taxed() bool {
+	if li.TaxRate != nil {
+		return *li.TaxRate > 0
+	}
+	return li.ItemTaxed
 }
 
 // InvoiceReply the status after creating or updating an invoice
 type InvoiceReply struct {
-	InvoiceID int    `json:"invoiceid"` // The ID of the invoice
-	Result    string `json:"result"`    // The result of the operation: success or error
-	Status    string `json:"status"`    // The status of the invoice
+	InvoiceID int           `json:"invoiceid"` // The ID of the invoice
+	Result    string        `json:"result"`    // The result of the operation: success or error
+	Status    InvoiceStatus `json:"status"`    // The status of the invoice
 }
@@ -0,0 +1,63 @@
+package whmcsgo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCreateInvoiceRequest_Validate(t *testing.T) {
+	date := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		invoice CreateInvoiceRequest
+		wantErr bool
+	}{
+		{
+			name: "Quantity and unit price",
+			invoice: CreateInvoiceRequest{UserID: 1, Status: "Unpaid", LineItems: []InvoiceLineItems{
+				{ItemOrder: 1, Quantity: 3, UnitPrice: 2.5, TaxRate: Float64(10)},
+				{ItemOrder: 2, ItemAmount: 7.5, Quantity: 3, UnitPrice: 2.5},
+			}},
+		},
+		{
+			name: "Amount does not match",
+			invoice: CreateInvoiceRequest{UserID: 1, Status: "Unpaid", LineItems: []InvoiceLineItems{
+				{ItemOrder: 1, ItemAmount: 8, Quantity: 3, UnitPrice: 2.5},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Mixed tax rates",
+			invoice: CreateInvoiceRequest{UserID: 1, Status: "Unpaid", LineItems: []InvoiceLineItems{
+				{ItemOrder: 1, ItemAmount: 1, TaxRate: Float64(10)},
+				{ItemOrder: 2, ItemAmount: 1, TaxRate: Float64(15)},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Due before date",
+			invoice: CreateInvoiceRequest{UserID: 1, Status: "Unpaid", Date: date, DueDate: date.AddDate(0, 0, -1),
+				LineItems: []InvoiceLineItems{{ItemOrder: 1, ItemAmount: 1}}},
+			wantErr: true,
+		},
+		{
+			name:    "No client",
+			invoice: CreateInvoiceRequest{Status: "Unpaid", LineItems: []InvoiceLineItems{{ItemOrder: 1, ItemAmount: 1}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.invoice.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("CreateInvoiceRequest.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_lineItemstoParams(t *testing.T) {
+	got := lineItemstoParams([]InvoiceLineItems{{ItemOrder: 1, ItemDescription: "Hours", Quantity: 1.5, UnitPrice: 80, TaxRate: Float64(10)}})
+	if got["itemamount1"] != "120.00" || got["itemtaxed1"] != FormatBool(true) {
+		t.Errorf("lineItemstoParams() got = %v", got)
+	}
+}
@@ -1,5 +1,24 @@
 package whmcsgo
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ClientActionReply the status after closing or deleting a client
+type ClientActionReply struct {
+	Result   string      `json:"result"`
+	Message  string      `json:"message"`
+	ClientID json.Number `json:"clientid"`
+}
+
+// DeleteClientOptions what is deleted with the client
+type DeleteClientOptions struct {
+	DeleteUsers        bool // Delete the users of the client that have no other clients
+	DeleteTransactions bool // Delete the transactions of the client
+}
+
 /*
 DeleteClient - Delete client
 
@@ -9,14 +28,56 @@ https://developers.whmcs.com/api-reference/deleteclient/
 
 Request Parameters
 
-see WHMCS API docs
+clientid
+	int	The ID of the client to delete	Required
+deleteusers
+	bool	Delete the users associated with the client that have no other clients	Optional
+deletetransactions
+	bool	Delete the transactions of the client	Optional
 */
-func (s *AccountsService) DeleteClient(parms map[string]string) (*Response, error) {
-	a := new(Account)
-	resp, err := apiRequest(s.client, Params{parms: parms, u: "DeleteClient"}, a)
+func (s *AccountsService) DeleteClient(clientID int, opts DeleteClientOptions) (*ClientActionReply, *Response, error) {
+	if clientID < 1 {
+		return nil, nil, errors.New("client ID required to delete a client")
+	}
+
+	parms := map[string]string{
+		"clientid":           fmt.Sprintf("%d", clientID),
+		"deleteusers":        FormatBool(opts.DeleteUsers),
+		"deletetransactions": FormatBool(opts.DeleteTransactions),
+	}
+
+	return s.clientAction("DeleteClient", parms)
+}
+
+/*
+CloseClient - Close a client, cancelling its services, domains and unpaid invoices
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/closeclient/
+
+Request Parameters
+
+clientid
+	int	The ID of the client to close	Required
+*/
+func (s *AccountsService) CloseClient(clientID int) (*ClientActionReply, *Response, error) {
+	if clientID < 1 {
+		return nil, nil, errors.New("client ID required to close a client")
+	}
+	return s.clientAction("CloseClient", map[string]string{"clientid": fmt.Sprintf("%d", clientID)})
+}
+
+// clientAction sends a client action and decodes the reply
+func (s *AccountsService) clientAction(action string, parms map[string]string) (*ClientActionReply, *Response, error) {
+	r := new(ClientActionReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: action}, r)
 	if err != nil {
-		return resp, err
+		return nil, resp, err
 	}
 
-	return resp, err
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
 }
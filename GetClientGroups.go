@@ -0,0 +1,61 @@
+package whmcsgo
+
+// ClientGroup a client group from WHMCS
+type ClientGroup struct {
+	ID               int       `json:"id"`
+	GroupName        string    `json:"groupname"`
+	GroupColour      string    `json:"groupcolour"`
+	DiscountPercent  string    `json:"discountpercent"`
+	SuspTermExempt   WHMCSbool `json:"susptermexempt"`
+	SeparateInvoices WHMCSbool `json:"separateinvoices"`
+}
+
+// ClientGroupsReply object from WHMCS
+type ClientGroupsReply struct {
+	Result       string `json:"result"`
+	Totalresults int    `json:"totalresults"`
+	Groups       struct {
+		Group []ClientGroup `json:"group"`
+	} `json:"groups"`
+}
+
+// Group returns the client group with the given ID
+func (r ClientGroupsReply) Group(id int) (ClientGroup, bool) {
+	for _, g := range r.Groups.Group {
+		if g.ID == id {
+			return g, true
+		}
+	}
+	return ClientGroup{}, false
+}
+
+// GetClientGroups Obtain the client groups
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/getclientgroups/
+func (s *AccountsService) GetClientGroups() (*ClientGroupsReply, *Response, error) {
+	r := new(ClientGroupsReply)
+	resp, err := apiRequest(s.client, Params{parms: map[string]string{}, u: "GetClientGroups"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// GetClientGroup returns the group of a client, ok is false when the client is not in a group
+func (s *AccountsService) GetClientGroup(client ClientInfo) (group ClientGroup, ok bool, err error) {
+	if client.Groupid < 1 {
+		return ClientGroup{}, false, nil
+	}
+
+	r, _, err := s.GetClientGroups()
+	if err != nil {
+		return ClientGroup{}, false, err
+	}
+
+	group, ok = r.Group(client.Groupid)
+	return group, ok, nil
+}
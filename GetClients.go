@@ -19,6 +19,8 @@ sorting
 	The direction to sort the results. ASC or DESC. Default: ASC Optional
 search
 	The search term to look for at the start of email, firstname, lastname, fullname or companyname Optional
+status
+	The status of the clients to return: Active, Inactive or Closed Optional
 */
 func (s *AccountsService) GetClients(parms map[string]string) (*WHMCSclients, *Response, error) {
 	obj := new(WHMCSclients)
@@ -2,6 +2,8 @@ package whmcsgo
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 )
 
 /*
@@ -19,7 +21,14 @@ https://developers.whmcs.com/api-reference/getclientsdetails/
 
 Request Parameters
 
-to many to list see WHMCS API docs
+clientid
+	int	The client id to obtain the details for. $clientid or $email is required	Optional
+email
+	string	The email address of the client to search for	Optional
+stats
+	bool	Also return additional client statistics	Optional
+
+Deprecated: use LookupClient for the typed client details and statistics.
 */
 func (s *AccountsService) GetClientsDetails(parms map[string]string) (*Account, *Response, error) {
 	a := new(Account)
@@ -32,3 +41,183 @@ func (s *AccountsService) GetClientsDetails(parms map[string]string) (*Account,
 
 	return a, resp, err
 }
+
+// ClientCustomField the value of a client custom field
+type ClientCustomField struct {
+	ID    int    `json:"id"`
+	Value string `json:"value"`
+}
+
+// ClientCustomFields the custom field values of a client
+type ClientCustomFields []ClientCustomField
+
+// Value returns the value of the custom field with the given ID
+func (c ClientCustomFields) Value(id int) (string, bool) {
+	for _, f := range c {
+		if f.ID == id {
+			return f.Value, true
+		}
+	}
+	return "", false
+}
+
+// UnmarshalJSON decodes the custom fields, accepting the empty values WHMCS sends
+func (c *ClientCustomFields) UnmarshalJSON(input []byte) error {
+	if isEmptyJSON(input) {
+		*c = nil
+		return nil
+	}
+
+	var fields []ClientCustomField
+	if err := json.Unmarshal(input, &fields); err != nil {
+		return err
+	}
+	*c = fields
+	return nil
+}
+
+// ClientInfo the client section of GetClientsDetails
+type ClientInfo struct {
+	ID                         int                `json:"id"`
+	UserID                     int                `json:"userid"`
+	UUID                       string             `json:"uuid"`
+	Firstname                  string             `json:"firstname"`
+	Lastname                   string             `json:"lastname"`
+	Fullname                   string             `json:"fullname"`
+	Companyname                string             `json:"companyname"`
+	Email                      string             `json:"email"`
+	Address1                   string             `json:"address1"`
+	Address2                   string             `json:"address2"`
+	City                       string             `json:"city"`
+	Fullstate                  string             `json:"fullstate"`
+	State                      string             `json:"state"`
+	Statecode                  string             `json:"statecode"`
+	Postcode                   string             `json:"postcode"`
+	Countrycode                string             `json:"countrycode"`
+	Country                    string             `json:"country"`
+	Countryname                string             `json:"countryname"`
+	Phonecc                    WHMCSint           `json:"phonecc"`
+	Phonenumber                string             `json:"phonenumber"`
+	Phonenumberformatted       string             `json:"phonenumberformatted"`
+	TelephoneNumber            string             `json:"telephoneNumber"`
+	TaxID                      string             `json:"tax_id"`
+	Billingcid                 int                `json:"billingcid"`
+	Securityqid                int                `json:"securityqid"`
+	Groupid                    int                `json:"groupid"`
+	Status                     string             `json:"status"`
+	Credit                     string             `json:"credit"`
+	Taxexempt                  WHMCSbool          `json:"taxexempt"`
+	Latefeeoveride             WHMCSbool          `json:"latefeeoveride"`
+	Overideduenotices          WHMCSbool          `json:"overideduenotices"`
+	Separateinvoices           WHMCSbool          `json:"separateinvoices"`
+	Disableautocc              WHMCSbool          `json:"disableautocc"`
+	Emailoptout                WHMCSbool          `json:"emailoptout"`
+	MarketingEmailsOptIn       WHMCSbool          `json:"marketing_emails_opt_in"`
+	IsOptedInToMarketingEmails WHMCSbool          `json:"isOptedInToMarketingEmails"`
+	Overrideautoclose          WHMCSbool          `json:"overrideautoclose"`
+	AllowSingleSignOn          WHMCSbool          `json:"allowSingleSignOn"`
+	Twofaenabled               WHMCSbool          `json:"twofaenabled"`
+	Language                   string             `json:"language"`
+	Lastlogin                  string             `json:"lastlogin"`
+	Currency                   int                `json:"currency"`
+	CurrencyCode               string             `json:"currency_code"`
+	Defaultgateway             string             `json:"defaultgateway"`
+	Notes                      string             `json:"notes"`
+	CustomFields               ClientCustomFields `json:"customfields"`
+}
+
+func (c ClientInfo) String() string {
+	return Stringify(c)
+}
+
+// ClientStats the account statistics returned by GetClientsDetails when stats is requested.
+// Amounts are formatted in the currency of the client
+type ClientStats struct {
+	NumDueInvoices            WHMCSint  `json:"numdueinvoices"`
+	DueInvoicesBalance        string    `json:"dueinvoicesbalance"`
+	NumOverdueInvoices        WHMCSint  `json:"numoverdueinvoices"`
+	OverdueInvoicesBalance    string    `json:"overdueinvoicesbalance"`
+	NumDraftInvoices          WHMCSint  `json:"numDraftInvoices"`
+	DraftInvoicesBalance      string    `json:"draftInvoicesBalance"`
+	NumPaidInvoices           WHMCSint  `json:"numpaidinvoices"`
+	PaidInvoicesAmount        string    `json:"paidinvoicesamount"`
+	NumUnpaidInvoices         WHMCSint  `json:"numunpaidinvoices"`
+	UnpaidInvoicesAmount      string    `json:"unpaidinvoicesamount"`
+	NumCancelledInvoices      WHMCSint  `json:"numcancelledinvoices"`
+	CancelledInvoicesAmount   string    `json:"cancelledinvoicesamount"`
+	NumRefundedInvoices       WHMCSint  `json:"numrefundedinvoices"`
+	RefundedInvoicesAmount    string    `json:"refundedinvoicesamount"`
+	NumCollectionsInvoices    WHMCSint  `json:"numcollectionsinvoices"`
+	CollectionsInvoicesAmount string    `json:"collectionsinvoicesamount"`
+	Income                    string    `json:"income"`
+	CreditBalance             string    `json:"creditbalance"`
+	ProductsNumActive         WHMCSint  `json:"productsnumactive"`
+	ProductsNumTotal          WHMCSint  `json:"productsnumtotal"`
+	NumActiveDomains          WHMCSint  `json:"numactivedomains"`
+	NumDomains                WHMCSint  `json:"numdomains"`
+	NumActiveTickets          WHMCSint  `json:"numactivetickets"`
+	NumTickets                WHMCSint  `json:"numtickets"`
+	NumQuotes                 WHMCSint  `json:"numquotes"`
+	NumAcceptedQuotes         WHMCSint  `json:"numacceptedquotes"`
+	NumAffiliateSignups       WHMCSint  `json:"numaffiliatesignups"`
+	IsAffiliate               WHMCSbool `json:"isAffiliate"`
+}
+
+// ClientDetails object from GetClientsDetails
+type ClientDetails struct {
+	Result  string       `json:"result"`
+	Message string       `json:"message"`
+	Client  ClientInfo   `json:"client"`
+	Stats   *ClientStats `json:"stats"` // Only set when stats are requested
+}
+
+func (c ClientDetails) String() string {
+	return Stringify(c)
+}
+
+// ClientLookup the client to look up with LookupClient
+type ClientLookup struct {
+	ClientID int    // The ID of the client
+	Email    string // The email address of the client, used when ClientID is zero
+	Stats    bool   // Also return the account statistics
+}
+
+/*
+LookupClient Obtain the typed details of a client by ID or email, along with
+the account statistics when requested.
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getclientsdetails/
+
+Request Parameters
+
+clientid
+	int	The client id to obtain the details for. $clientid or $email is required	Optional
+email
+	string	The email address of the client to search for	Optional
+stats
+	bool	Also return additional client statistics	Optional
+*/
+func (s *AccountsService) LookupClient(lookup ClientLookup) (*ClientDetails, *Response, error) {
+	parms := map[string]string{"stats": FormatBool(lookup.Stats)}
+	switch {
+	case lookup.ClientID > 0:
+		parms["clientid"] = fmt.Sprintf("%d", lookup.ClientID)
+	case len(lookup.Email) > 0:
+		parms["email"] = lookup.Email
+	default:
+		return nil, nil, errors.New("client ID or email required to look up a client")
+	}
+
+	r := new(ClientDetails)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetClientsDetails"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
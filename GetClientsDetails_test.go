@@ -0,0 +1,44 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestAccountsService_LookupClient(t *testing.T) {
+	var stats string
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		stats = req.PostForm.Get("stats")
+		body := `{"result":"success","userid":3,"client":{"id":3,"firstname":"Jo","groupid":2,"phonecc":"61","taxexempt":false,"disableautocc":true,` +
+			`"customfields":[{"id":1,"value":"VM-12"},{"id":3,"value":"Acme"}]},` +
+			`"stats":{"numdueinvoices":"2","dueinvoicesbalance":"$20.00 AUD","income":"$100.00 AUD","creditbalance":"$0.00 AUD",` +
+			`"productsnumactive":1,"productsnumtotal":"3","isAffiliate":false}}`
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &AccountsService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	got, _, err := s.LookupClient(ClientLookup{ClientID: 3, Stats: true})
+	if err != nil {
+		t.Fatalf("AccountsService.LookupClient() error = %v", err)
+	}
+	if stats != "1" {
+		t.Errorf("AccountsService.LookupClient() stats = %q, want 1", stats)
+	}
+	if got.Client.Phonecc != 61 || !got.Client.Disableautocc {
+		t.Errorf("AccountsService.LookupClient() client = %v", got.Client)
+	}
+	if v, ok := got.Client.CustomFields.Value(3); !ok || v != "Acme" {
+		t.Errorf("AccountsService.LookupClient() custom field 3 = %q", v)
+	}
+	if got.Stats == nil || got.Stats.NumDueInvoices != 2 || got.Stats.ProductsNumTotal != 3 || got.Stats.Income != "$100.00 AUD" {
+		t.Errorf("AccountsService.LookupClient() stats = %v", got.Stats)
+	}
+}
@@ -1,19 +1,199 @@
 package whmcsgo
 
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Client statuses accepted by UpdateClient
+const (
+	ClientActive   = "Active"
+	ClientInactive = "Inactive"
+	ClientClosed   = "Closed"
+)
+
+// UpdateClientReply the status after updating a client
+type UpdateClientReply struct {
+	Result   string      `json:"result"`
+	Message  string      `json:"message"`
+	ClientID json.Number `json:"clientid"` // WHMCS may send the ID as a string
+}
+
+// ClientUpdate the changes to make to a client, only set fields are sent
+type ClientUpdate struct {
+	FirstName         *string
+	LastName          *string
+	CompanyName       *string
+	Email             *string
+	Address1          *string
+	Address2          *string
+	City              *string
+	State             *string
+	Postcode          *string
+	Country           *string // 2 character ISO country code
+	PhoneNumber       *string
+	TaxID             *string
+	Currency          *int
+	Language          *string
+	ClientIP          *string
+	Password          *string
+	SecurityQID       *int
+	SecurityQAns      *string
+	PaymentMethod     *string
+	Status            *string // Active, Inactive or Closed
+	GroupID           *int
+	Credit            *float64
+	Notes             *string
+	TaxExempt         *bool
+	LateFeeOveride    *bool
+	OverideDueNotices *bool
+	SeparateInvoices  *bool
+	DisableAutoCC     *bool
+	EmailOptOut       *bool
+	MarketingOptIn    *bool
+	OverrideAutoClose *bool
+	AllowSingleSignOn *bool
+	ClearCreditCard   bool           // Remove the stored credit card details of the client
+	SkipValidation    bool           // Skip the validation of required fields
+	CustomFields      map[int]string // Custom field ID => value
+}
+
+func (c ClientUpdate) toParams() (map[string]string, error) {
+	parms := map[string]string{}
+
+	if c.Status != nil {
+		switch *c.Status {
+		case ClientActive, ClientInactive, ClientClosed:
+		default:
+			return nil, fmt.Errorf("unsupported client status: %s", *c.Status)
+		}
+	}
+
+	strs := []struct {
+		key string
+		v   *string
+	}{
+		{"firstname", c.FirstName},
+		{"lastname", c.LastName},
+		{"companyname", c.CompanyName},
+		{"email", c.Email},
+		{"address1", c.Address1},
+		{"address2", c.Address2},
+		{"city", c.City},
+		{"state", c.State},
+		{"postcode", c.Postcode},
+		{"country", c.Country},
+		{"phonenumber", c.PhoneNumber},
+		{"tax_id", c.TaxID},
+		{"language", c.Language},
+		{"clientip", c.ClientIP},
+		{"password2", c.Password},
+		{"securityqans", c.SecurityQAns},
+		{"paymentmethod", c.PaymentMethod},
+		{"status", c.Status},
+		{"notes", c.Notes},
+	}
+	for _, s := range strs {
+		if s.v != nil {
+			parms[s.key] = *s.v
+		}
+	}
+
+	ints := []struct {
+		key string
+		v   *int
+	}{
+		{"currency", c.Currency},
+		{"securityqid", c.SecurityQID},
+		{"groupid", c.GroupID},
+	}
+	for _, i := range ints {
+		if i.v != nil {
+			parms[i.key] = fmt.Sprintf("%d", *i.v)
+		}
+	}
+
+	bools := []struct {
+		key string
+		v   *bool
+	}{
+		{"taxexempt", c.TaxExempt},
+		{"latefeeoveride", c.LateFeeOveride},
+		{"overideduenotices", c.OverideDueNotices},
+		{"separateinvoices", c.SeparateInvoices},
+		{"disableautocc", c.DisableAutoCC},
+		{"emailoptout", c.EmailOptOut},
+		{"marketingoptin", c.MarketingOptIn},
+		{"overrideautoclose", c.OverrideAutoClose},
+		{"allowSingleSignOn", c.AllowSingleSignOn},
+	}
+	for _, b := range bools {
+		if b.v != nil {
+			parms[b.key] = FormatBool(*b.v)
+		}
+	}
+
+	if c.Credit != nil {
+		parms["credit"] = fmt.Sprintf("%.2f", *c.Credit)
+	}
+	if c.ClearCreditCard {
+		parms["clearcreditcard"] = FormatBool(c.ClearCreditCard)
+	}
+	if c.SkipValidation {
+		parms["skipvalidation"] = FormatBool(c.SkipValidation)
+	}
+	if len(c.CustomFields) > 0 {
+		parms["customfields"] = serializeCustomFields(c.CustomFields)
+	}
+
+	return parms, nil
+}
+
 /*
 UpdateClient Updates a client with the passed parameters.
 
 WHMCS API docs
 
 https://developers.whmcs.com/api-reference/updateclient/
+
+Request Parameters
+
+clientid
+	int	The ID of the client to update	Required
+customfields
+	string	Base64 encoded serialized array of custom field values	Optional
+clearcreditcard
+	bool	Remove the stored credit card details	Optional
+status
+	string	The status of the client: Active, Inactive or Closed	Optional
+marketingoptin
+	bool	Opt the client in to marketing emails	Optional
+
+Other client fields as per ClientUpdate, see WHMCS API docs
+
+The request is abandoned when ctx is cancelled.
 */
-func (s *AccountsService) UpdateClient() (*Account, *Response, error) {
-	a := new(Account)
-	var parms map[string]string
+func (s *AccountsService) UpdateClient(ctx context.Context, clientID int, update ClientUpdate) (*UpdateClientReply, *Response, error) {
+	if clientID < 1 {
+		return nil, nil, errors.New("client ID required to update a client")
+	}
 
-	resp, err := apiRequest(s.client, Params{parms: parms, u: "updateclient"}, a)
+	parms, err := update.toParams()
 	if err != nil {
+		return nil, nil, err
+	}
+	parms["clientid"] = fmt.Sprintf("%d", clientID)
+
+	r := new(UpdateClientReply)
+	resp, err := apiRequestContext(ctx, s.client, Params{parms: parms, u: "UpdateClient"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
 		return nil, resp, err
 	}
-	return a, resp, err
+	return r, resp, nil
 }
@@ -1,6 +1,11 @@
 package whmcsgo
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
 
 /*
 UpdateClientProduct Updates a Client Service
@@ -87,6 +92,29 @@ result
 serviceid
 	int	The Id of the updated service
 */
+func (s *ServiceService) UpdateClientProduct(serviceID int, update ServiceUpdate) (*UpdateClientProductReply, *Response, error) {
+	if serviceID < 1 {
+		return nil, nil, errors.New("service ID required to update a client product")
+	}
+
+	parms := update.toParams()
+	parms["serviceid"] = fmt.Sprintf("%d", serviceID)
+
+	obj := new(UpdateClientProductReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "UpdateClientProduct"}, obj)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, obj); err != nil {
+		return nil, resp, err
+	}
+	return obj, resp, nil
+}
+
+// UpdateClientProduct Updates a Client Service with raw request parameters
+//
+// Deprecated: use ServiceService.UpdateClientProduct with a ServiceUpdate
 func (s *SystemService) UpdateClientProduct(parms map[string]string) (*UpdateClientProductReply, *Response, error) {
 	obj := new(UpdateClientProductReply)
 	resp, err := apiRequest(s.client, Params{parms: parms, u: "UpdateClientProduct"}, obj)
@@ -98,7 +126,119 @@ func (s *SystemService) UpdateClientProduct(parms map[string]string) (*UpdateCli
 	return obj, resp, err
 }
 
+// UpdateClientProductReply the status after updating a client service
 type UpdateClientProductReply struct {
-	Result    string `json:"result"`
-	Serviceid string `json:"serviceid"`
+	Result    string      `json:"result"`
+	Serviceid json.Number `json:"serviceid"`
+}
+
+// ServiceUpdate the fields of a client service to update, only fields that
+// are set are sent to WHMCS
+type ServiceUpdate struct {
+	PID                 *int           // The package id to associate with the service
+	ServerID            *int           // The server id to associate with the service
+	RegDate             *time.Time     // The registration date of the service
+	NextDueDate         *time.Time     // The next due date of the service
+	TerminationDate     *time.Time     // The termination date of the service
+	CompletedDate       *time.Time     // The completed date of the service
+	Domain              *string        // The domain name to be changed to
+	FirstPaymentAmount  *float64       // The first payment amount on the service
+	RecurringAmount     *float64       // The recurring amount for automatic renewal invoices
+	PaymentMethod       *string        // The payment method in system format (eg paypal)
+	BillingCycle        *string        // The term the product is billed on (eg Monthly)
+	SubscriptionID      *string        // The subscription ID to associate with the service
+	Status              *string        // The status to change the service to
+	Notes               *string        // The admin notes for the service
+	ServiceUsername     *string        // The service username
+	ServicePassword     *string        // The service password
+	OverideAutoSuspend  *bool          // Should auto suspend be overridden
+	OverideSuspendUntil *time.Time     // The date to override auto suspend until
+	NS1                 *string        // (VPS/Dedicated servers only)
+	NS2                 *string        // (VPS/Dedicated servers only)
+	DedicatedIP         *string        // The dedicated IP of the service
+	AssignedIPs         *string        // (VPS/Dedicated servers only)
+	DiskUsage           *int           // The disk usage in megabytes
+	DiskLimit           *int           // The disk limit in megabytes
+	BwUsage             *int           // The bandwidth usage in megabytes
+	BwLimit             *int           // The bandwidth limit in megabytes
+	SuspendReason       *string        // The reason the service is suspended
+	PromoID             *int           // The promotion Id to associate
+	Unset               []string       // Fields to unset eg domain, notes, dedicatedip
+	AutoRecalc          *bool          // Recalculate the recurring amount, ignores RecurringAmount
+	CustomFields        map[int]string // Custom field ID => value
+	ConfigOptions       map[int]int    // Configurable option ID => option ID or quantity
+}
+
+func (u ServiceUpdate) toParams() map[string]string {
+	parms := map[string]string{}
+	layout := "2006-01-02"
+
+	setInt := func(k string, v *int) {
+		if v != nil {
+			parms[k] = fmt.Sprintf("%d", *v)
+		}
+	}
+	setString := func(k string, v *string) {
+		if v != nil {
+			parms[k] = *v
+		}
+	}
+	setDate := func(k string, v *time.Time) {
+		if v != nil {
+			parms[k] = v.Format(layout)
+		}
+	}
+	setFloat := func(k string, v *float64) {
+		if v != nil {
+			parms[k] = fmt.Sprintf("%.2f", *v)
+		}
+	}
+
+	setInt("pid", u.PID)
+	setInt("serverid", u.ServerID)
+	setDate("regdate", u.RegDate)
+	setDate("nextduedate", u.NextDueDate)
+	setDate("terminationDate", u.TerminationDate)
+	setDate("completedDate", u.CompletedDate)
+	setString("domain", u.Domain)
+	setFloat("firstpaymentamount", u.FirstPaymentAmount)
+	setFloat("recurringamount", u.RecurringAmount)
+	setString("paymentmethod", u.PaymentMethod)
+	setString("billingcycle", u.BillingCycle)
+	setString("subscriptionid", u.SubscriptionID)
+	setString("status", u.Status)
+	setString("notes", u.Notes)
+	setString("serviceusername", u.ServiceUsername)
+	setString("servicepassword", u.ServicePassword)
+	if u.OverideAutoSuspend != nil {
+		parms["overideautosuspend"] = "off"
+		if *u.OverideAutoSuspend {
+			parms["overideautosuspend"] = "on"
+		}
+	}
+	setDate("overidesuspenduntil", u.OverideSuspendUntil)
+	setString("ns1", u.NS1)
+	setString("ns2", u.NS2)
+	setString("dedicatedip", u.DedicatedIP)
+	setString("assignedips", u.AssignedIPs)
+	setInt("diskusage", u.DiskUsage)
+	setInt("disklimit", u.DiskLimit)
+	setInt("bwusage", u.BwUsage)
+	setInt("bwlimit", u.BwLimit)
+	setString("suspendreason", u.SuspendReason)
+	setInt("promoid", u.PromoID)
+	for i, f := range u.Unset {
+		parms[fmt.Sprintf("unset[%d]", i)] = f
+	}
+	if u.AutoRecalc != nil {
+		parms["autorecalc"] = FormatBool(*u.AutoRecalc)
+	}
+	if len(u.CustomFields) > 0 {
+		parms["customfields"] = serializeCustomFields(u.CustomFields)
+	}
+	if len(u.ConfigOptions) > 0 {
+		parms["configoptions"] = serializeConfigOptions(u.ConfigOptions)
+	}
+
+	return parms
 }
@@ -0,0 +1,79 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestAccountsService_UpdateClient(t *testing.T) {
+	var form url.Values
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		form = req.PostForm
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"result":"success","clientid":"7"}`)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &AccountsService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	got, _, err := s.UpdateClient(context.Background(), 7, ClientUpdate{
+		CompanyName:     String(""),
+		Status:          String(ClientInactive),
+		MarketingOptIn:  Bool(true),
+		ClearCreditCard: true,
+		CustomFields:    map[int]string{2: "abc"},
+	})
+	if err != nil {
+		t.Fatalf("AccountsService.UpdateClient() error = %v", err)
+	}
+	if got.ClientID.String() != "7" {
+		t.Errorf("AccountsService.UpdateClient() ClientID = %s, want 7", got.ClientID)
+	}
+
+	cf, _ := base64.StdEncoding.DecodeString(form.Get("customfields"))
+	if form.Get("action") != "UpdateClient" || form.Get("clientid") != "7" || form.Get("status") != "Inactive" ||
+		form.Get("marketingoptin") != "1" || form.Get("clearcreditcard") != "1" || string(cf) != `a:1:{i:2;s:3:"abc";}` {
+		t.Errorf("AccountsService.UpdateClient() sent %v", form)
+	}
+	if _, ok := form["companyname"]; !ok {
+		t.Errorf("AccountsService.UpdateClient() did not send the cleared company name")
+	}
+	if _, ok := form["firstname"]; ok {
+		t.Errorf("AccountsService.UpdateClient() sent an unset field")
+	}
+
+	if _, _, err := s.UpdateClient(context.Background(), 7, ClientUpdate{Status: String("Deleted")}); err == nil {
+		t.Errorf("AccountsService.UpdateClient() expected error for an unsupported status")
+	}
+}
+
+func TestAccountsService_UpdateClient_context(t *testing.T) {
+	type key struct{}
+	var got interface{}
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		got = req.Context().Value(key{})
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"result":"success","clientid":"7"}`)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &AccountsService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	ctx := context.WithValue(context.Background(), key{}, "request")
+	if _, _, err := s.UpdateClient(ctx, 7, ClientUpdate{Notes: String("note")}); err != nil {
+		t.Fatalf("AccountsService.UpdateClient() error = %v", err)
+	}
+	if got != "request" {
+		t.Errorf("AccountsService.UpdateClient() sent the request without its context")
+	}
+}
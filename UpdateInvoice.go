@@ -1,8 +1,9 @@
 package whmcsgo
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 )
 
 /*
@@ -59,24 +60,119 @@ invoiceid
 	int	The ID of the invoice
 
 */
-func (s *BillingService) UpdateInvoice(invoiceID int, items []InvoiceLineItems) (*InvoiceReply, *Response, error) {
+func (s *BillingService) UpdateInvoice(invoiceID int, update UpdateInvoiceRequest) (*InvoiceReply, *Response, error) {
+	if invoiceID < 1 {
+		return nil, nil, errors.New("invoice ID required to update an invoice")
+	}
+
+	parms, err := update.toParams()
+	if err != nil {
+		return nil, nil, err
+	}
+	parms["invoiceid"] = fmt.Sprintf("%d", invoiceID)
 
 	i := new(InvoiceReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "UpdateInvoice"}, i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, i); err != nil {
+		return nil, resp, err
+	}
+	return i, resp, nil
+}
+
+// UpdateInvoiceRequest the changes to make to an invoice, only set fields are sent
+type UpdateInvoiceRequest struct {
+	Status              InvoiceStatus       // The status of the invoice
+	PaymentMethod       string              // The payment method of the invoice in system format
+	TaxRate             *float64            // The first level tax rate
+	TaxRate2            *float64            // The second level tax rate
+	Credit              *float64            // The credit applied to the invoice
+	Date                time.Time           // The date that the invoice should show as created
+	DueDate             time.Time           // The due date of the invoice
+	DatePaid            time.Time           // The date the invoice was paid
+	Notes               *string             // The notes to appear on the invoice
+	EditItems           []InvoiceItemUpdate // Existing line items to change
+	NewItems            []InvoiceLineItems  // Line items to add
+	DeleteLineIDs       []int               // The IDs of existing line items to remove
+	Publish             bool                // Publish a draft invoice
+	PublishAndSendEmail bool                // Publish a draft invoice and email it to the client
+}
+
+// InvoiceItemUpdate the new values of an existing invoice line item
+type InvoiceItemUpdate struct {
+	ID          int     // The ID of the line item from GetInvoice
+	Description string  // The line items description
+	Amount      float64 // The line items amount
+	Taxed       bool    // The line items is taxed value
+}
+
+func (u UpdateInvoiceRequest) toParams() (map[string]string, error) {
 	parms := map[string]string{}
+	layout := "2006-01-02"
+
+	if u.Publish && u.PublishAndSendEmail {
+		return nil, errors.New("only one of publish and publish and send email can be set")
+	}
 
-	for _, li := range items {
+	if len(u.Status) > 0 {
+		if !u.Status.Valid() {
+			return nil, fmt.Errorf("unsupported invoice status: %s", u.Status)
+		}
+		parms["status"] = string(u.Status)
+	}
+	if len(u.PaymentMethod) > 0 {
+		parms["paymentmethod"] = u.PaymentMethod
+	}
+	if u.TaxRate != nil {
+		parms["taxrate"] = fmt.Sprintf("%.2f", *u.TaxRate)
+	}
+	if u.TaxRate2 != nil {
+		parms["taxrate2"] = fmt.Sprintf("%.2f", *u.TaxRate2)
+	}
+	if u.Credit != nil {
+		parms["credit"] = fmt.Sprintf("%.2f", *u.Credit)
+	}
+	if !u.Date.IsZero() {
+		parms["date"] = u.Date.Format(layout)
+	}
+	if !u.DueDate.IsZero() {
+		parms["duedate"] = u.DueDate.Format(layout)
+	}
+	if !u.DatePaid.IsZero() {
+		parms["datepaid"] = u.DatePaid.Format(layout)
+	}
+	if u.Notes != nil {
+		parms["notes"] = *u.Notes
+	}
+
+	for _, li := range u.EditItems {
+		if li.ID < 1 {
+			return nil, errors.New("line item ID required to edit an invoice line item")
+		}
+		parms[fmt.Sprintf("itemdescription[%d]", li.ID)] = li.Description
+		parms[fmt.Sprintf("itemamount[%d]", li.ID)] = fmt.Sprintf("%.2f", li.Amount)
+		parms[fmt.Sprintf("itemtaxed[%d]", li.ID)] = FormatBool(li.Taxed)
+	}
+
+	for _, li := range u.NewItems {
 		parms[fmt.Sprintf("newitemdescription[%d]", li.ItemOrder)] = li.ItemDescription
-		parms[fmt.Sprintf("newitemamount[%d]", li.ItemOrder)] = fmt.Sprintf("%.2f", li.ItemAmount)
-		parms[fmt.Sprintf("newitemtaxed[%d]", li.ItemOrder)] = FormatBool(li.ItemTaxed)
+		parms[fmt.Sprintf("newitemamount[%d]", li.ItemOrder)] = fmt.Sprintf("%.2f", li.Amount())
+		parms[fmt.Sprintf("newitemtaxed[%d]", li.ItemOrder)] = FormatBool(li.taxed())
 	}
 
-	parms["invoiceid"] = fmt.Sprintf("%d", invoiceID)
+	for i, id := range u.DeleteLineIDs {
+		parms[fmt.Sprintf("deletelineids[%d]", i)] = fmt.Sprintf("%d", id)
+	}
 
-	resp, err := apiRequest(s.client, Params{parms: parms, u: "UpdateInvoice"}, i)
-	if err != nil {
-		return nil, resp, err
+	if u.Publish {
+		parms["publish"] = FormatBool(u.Publish)
+	}
+	if u.PublishAndSendEmail {
+		parms["publishandsendemail"] = FormatBool(u.PublishAndSendEmail)
 	}
 
-	json.Unmarshal([]byte(resp.Body), &i)
-	return i, resp, err
+	return parms, nil
 }
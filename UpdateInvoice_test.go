@@ -0,0 +1,49 @@
+package whmcsgo
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUpdateInvoiceRequest_toParams(t *testing.T) {
+	update := UpdateInvoiceRequest{
+		Status:        "Unpaid",
+		TaxRate:       Float64(10),
+		DueDate:       time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+		Notes:         String(""),
+		EditItems:     []InvoiceItemUpdate{{ID: 40, Description: "Consulting", Amount: 12.5, Taxed: true}},
+		NewItems:      []InvoiceLineItems{{ItemOrder: 1, ItemDescription: "Setup", ItemAmount: 5}},
+		DeleteLineIDs: []int{41, 42},
+		Publish:       true,
+	}
+
+	got, err := update.toParams()
+	if err != nil {
+		t.Fatalf("UpdateInvoiceRequest.toParams() error = %v", err)
+	}
+
+	want := map[string]string{
+		"status":                "Unpaid",
+		"taxrate":               "10.00",
+		"duedate":               "2021-04-01",
+		"notes":                 "",
+		"itemdescription[40]":   "Consulting",
+		"itemamount[40]":        "12.50",
+		"itemtaxed[40]":         FormatBool(true),
+		"newitemdescription[1]": "Setup",
+		"newitemamount[1]":      "5.00",
+		"newitemtaxed[1]":       FormatBool(false),
+		"deletelineids[0]":      "41",
+		"deletelineids[1]":      "42",
+		"publish":               FormatBool(true),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UpdateInvoiceRequest.toParams() got = %v, want %v", got, want)
+	}
+
+	update.PublishAndSendEmail = true
+	if _, err := update.toParams(); err == nil {
+		t.Errorf("UpdateInvoiceRequest.toParams() expected error with both publish options")
+	}
+}
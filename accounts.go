@@ -2,8 +2,9 @@ package whmcsgo
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strings"
+	"time"
 )
 
 // AccountsService handles communication with the Client related
@@ -164,58 +165,6 @@ func (u Account) String() string {
 	return Stringify(u)
 }
 
-/*
-GetContacts Obtain the Client Contacts that match passed criteria
-
-WHMCS API docs
-
-https://developers.whmcs.com/api-reference/getcontacts/
-
-Request Parameters
-
-limitstart
-	The offset for the returned log data (default: 0) Optional
-limitnum
-	The number of records to return (default: 25) Optional
-userid
-	Find contacts for a specific client id Optional
-firstname
-	Find contacts with a specific first name Optional
-lastname
-	Find contacts with a specific last name Optional
-companyname
-	Find contacts with a specific company name Optional
-email
-	Find contacts with a specific email address Optional
-address1
-	Find contacts with a specific address line 1 Optional
-address2
-	Find contacts with a specific address line 2 Optional
-city
-	Find contacts with a specific city Optional
-state
-	Find contacts with a specific state Optional
-postcode
-	Find contacts with a specific post/zip code Optional
-country
-	Find contacts with a specific country Optional
-phonenumber
-	Find contacts with a specific phone number Optional
-subaccount
-	Search for sub-accounts Optional
-*/
-func (s *AccountsService) GetContacts(parms map[string]string) (*Account, *Response, error) {
-	a := new(Account)
-	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetContacts"}, a)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	json.Unmarshal([]byte(resp.Body), &a)
-
-	return a, resp, err
-}
-
 // WHMCSclient opbect
 type WHMCSclient struct {
 	Companyname string    `json:"companyname"`
@@ -241,115 +190,306 @@ type WHMCSclients struct {
 
 // ContactList quick contact list
 type ContactList struct {
-	CompanyName    string `json:"companyname"`
-	FullName       string `json:"fullname"`
-	Phone          string `json:"phonenumberformatted"`
-	VendorSoftware string `json:"customfields3"`
-	VMRef          string `json:"customfields1"`
-	AlertPrimary   string `json:"customfields5"`
-	Status         string `json:"status"`
-	UserID         int    `json:"userid"`
-	State          string `json:"state"`
-	Email          string `json:"email"`
-	GroupID        int    `json:"groupid"`
+	CompanyName    string   `json:"companyname"`
+	FullName       string   `json:"fullname"`
+	Phone          string   `json:"phonenumberformatted"`
+	PhoneCC        WHMCSint `json:"phonecc"`
+	VendorSoftware string   `json:"-" whmcs:"customfield=Vendor Software"`
+	VMRef          string   `json:"-" whmcs:"customfield=VM Reference"`
+	AlertPrimary   string   `json:"-" whmcs:"customfield=Alert Primary"`
+	Status         string   `json:"status"`
+	UserID         int      `json:"userid"`
+	State          string   `json:"state"`
+	Email          string   `json:"email"`
+	GroupID        int      `json:"groupid"`
+}
+
+/*
+ContactListOptions controls how ClientContactListWithOptions builds the list.
+
+CustomFieldDefs maps the custom field names used by ContactList, "Vendor
+Software", "VM Reference" and "Alert Primary", to their IDs in this WHMCS
+install. Without it the custom field columns are left empty.
+*/
+type ContactListOptions struct {
+	Phone           PhoneFormatter  // How phone numbers are written, defaults to national format for Australian numbers
+	Concurrency     int             // The number of client details requested at once
+	CustomFieldDefs CustomFieldDefs // The IDs of the ContactList custom fields
 }
 
 /*
 ClientContactList list of contact for a given status
+
+Australian phone numbers are written in national format and all others in
+international format, use ClientContactListWithOptions to change this.
 */
 func (s *AccountsService) ClientContactList(status string) ([]ContactList, error) {
-	params := map[string]string{"sorting": "ASC", "limitstart": "0", "limitnum": "2500"}
+	return s.ClientContactListWithOptions(status, ContactListOptions{})
+}
 
-	var contactList []ContactList
+/*
+ClientContactListWithOptions list of contact for a given status
 
-	//	obj := new(WHMCSclients)
-	obj, _, err := s.GetClients(params)
+The client details are fetched concurrently. Clients whose details can not be
+fetched are left out and reported in the returned ClientErrors.
+*/
+func (s *AccountsService) ClientContactListWithOptions(status string, opts ContactListOptions) ([]ContactList, error) {
+	phone := opts.Phone
+	if phone == nil {
+		phone = HomePhoneFormatter{CountryCode: 61}
+	}
 
+	ids, err := s.clientIDs(status)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, c := range obj.Clients.Client {
+	contacts := make([]ContactList, len(ids))
+	fetched := make([]bool, len(ids))
 
-		if c.Status == status {
-			cl := ContactList{}
-			clientid := fmt.Sprintf("%d", c.ID)
-			clParams := map[string]string{"clientid": clientid}
+	err = s.FetchClientsDetails(ids, opts.Concurrency, func(i int, body []byte) error {
+		cl := ContactList{}
+		if err := json.Unmarshal(body, &cl); err != nil {
+			return err
+		}
 
-			resp, err := apiRequest(s.client, Params{parms: clParams, u: "GetClientsDetails"}, cl)
-			if err != nil {
-				fmt.Println(err)
+		if opts.CustomFieldDefs != nil {
+			var details ClientDetails
+			if err := json.Unmarshal(body, &details); err != nil {
+				return err
 			}
-			json.Unmarshal([]byte(resp.Body), &cl)
-
-			cl.VendorSoftware = strings.TrimSpace(cl.VendorSoftware)
-			cl.Phone = strings.Replace(cl.Phone, "+61.", "0", -1)
-			cl.Phone = strings.Replace(cl.Phone, " ", "", -1)
-			if len(cl.Phone) == 10 {
-				cl.Phone = fmt.Sprintf("%s %s %s", cl.Phone[0:4], cl.Phone[4:7], cl.Phone[7:10])
+			if err := DecodeCustomFields(details.Client.CustomFields.Map(), opts.CustomFieldDefs, &cl); err != nil {
+				return err
 			}
+		}
 
-			cl.AlertPrimary = strings.Replace(cl.AlertPrimary, " ", "", -1)
-			if len(cl.AlertPrimary) == 10 {
-				cl.AlertPrimary = fmt.Sprintf("%s %s %s", cl.AlertPrimary[0:4], cl.AlertPrimary[4:7], cl.AlertPrimary[7:10])
-			}
+		cl.Phone = FormatPhone(phone, cl.Phone, int(cl.PhoneCC))
+		cl.AlertPrimary = FormatPhone(phone, cl.AlertPrimary, int(cl.PhoneCC))
+
+		contacts[i] = cl
+		fetched[i] = true
+		return nil
+	})
 
+	var contactList []ContactList
+	for i, cl := range contacts {
+		if fetched[i] {
 			contactList = append(contactList, cl)
 		}
 	}
 
-	return contactList, nil
+	return contactList, err
+}
+
+// clientIDs returns the IDs of the clients with the given status
+func (s *AccountsService) clientIDs(status string) ([]int, error) {
+	clients, err := s.clientsWithStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(clients))
+	for _, c := range clients {
+		ids = append(ids, c.ID)
+	}
+	return ids, nil
+}
+
+// EachClient calls fn for every client matching the GetClients filters in parms, requesting pageSize clients at a time
+func (s *AccountsService) EachClient(parms map[string]string, pageSize int, fn func(WHMCSclient) error) error {
+	return paginate(pageSize, func(start, num int) (int, int, error) {
+		p := copyParms(parms)
+		p["limitstart"] = fmt.Sprintf("%d", start)
+		p["limitnum"] = fmt.Sprintf("%d", num)
+
+		r, _, err := s.GetClients(p)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, c := range r.Clients.Client {
+			if err := fn(c); err != nil {
+				return 0, 0, err
+			}
+		}
+		return len(r.Clients.Client), r.Totalresults, nil
+	})
+}
+
+// clientsWithStatus returns the clients with the given status, or every client when status is empty
+func (s *AccountsService) clientsWithStatus(status string) ([]WHMCSclient, error) {
+	params := map[string]string{"sorting": "ASC"}
+	if status != "" {
+		params["status"] = status
+	}
+
+	var clients []WHMCSclient
+	err := s.EachClient(params, defaultPageSize, func(c WHMCSclient) error {
+		if status == "" || c.Status == status {
+			clients = append(clients, c)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clients, nil
 }
 
-// ClientLastBilledList quick contact list
+// ClientLastBilledList the last invoice of a client
 type ClientLastBilledList struct {
-	CompanyName string `json:"companyname"`
-	Date        string `json:"date"`
-	Total       string `json:"total"`
-	Status      string `json:"status"`
+	ClientID        int           // The ID of the client
+	CompanyName     string        // The company name of the client
+	ClientStatus    string        // The status of the client
+	InvoiceID       int           // The ID of the last invoice, zero when the client has never been billed
+	Date            string        // The date of the last invoice YYYY-MM-DD
+	Total           string        // The total of the last invoice
+	Currency        string        // The currency code of the last invoice
+	Status          InvoiceStatus // The status of the last invoice
+	DaysSinceBilled int           // The days since the last invoice date, -1 when the client has never been billed
+}
+
+// LastBilledOptions filters the clients returned by ClientLastBilledWithOptions
+type LastBilledOptions struct {
+	ClientStatus string    // Only include clients with this status, all clients when empty
+	Currency     string    // Only include clients last billed in this currency code, all currencies when empty
+	Now          time.Time // The time days since billed is measured from, defaults to the current time
 }
 
 /*
-ClientLastBilled list of the last invoice date
+ClientLastBilled list of the last invoice date for clients with the given status
 */
 func (s *AccountsService) ClientLastBilled(status string) ([]ClientLastBilledList, error) {
-	params := map[string]string{"sorting": "ASC", "limitstart": "0", "limitnum": "2500"}
+	return s.ClientLastBilledWithOptions(LastBilledOptions{ClientStatus: status})
+}
 
-	var contactList []ClientLastBilledList
+// lastBilledPerClientMax the most clients ClientLastBilledWithOptions requests invoices for one client at a time
+const lastBilledPerClientMax = 20
 
-	// obj := new(WHMCSclients)
-	obj, _, err := s.GetClients(params)
+// errStopPaging returned by a paging callback to stop reading further pages
+var errStopPaging = errors.New("stop paging")
 
+// billedBy reports whether the invoice counts as billing its client
+func billedBy(i Invoice) bool {
+	return i.Status != InvoiceDraft && i.Status != InvoiceCancelled
+}
+
+/*
+ClientLastBilledWithOptions list of the last invoice of each client.
+
+Invoices are read newest first, draft and cancelled invoices are not counted
+as billing the client. Up to lastBilledPerClientMax clients the invoices of
+each client are requested separately, otherwise GetInvoices is scanned once
+until every client has been seen. Clients that have never been billed are
+included unless a currency filter is set.
+*/
+func (s *AccountsService) ClientLastBilledWithOptions(opts LastBilledOptions) ([]ClientLastBilledList, error) {
+	clients, err := s.clientsWithStatus(opts.ClientStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	var last map[int]Invoice
+	if len(clients) <= lastBilledPerClientMax {
+		last, err = s.lastInvoicePerClient(clients)
+	} else {
+		last, err = s.lastInvoiceScan(clients)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	for _, c := range obj.Clients.Client {
+	var billed []ClientLastBilledList
+	for _, c := range clients {
+		lb := ClientLastBilledList{
+			ClientID:        c.ID,
+			CompanyName:     c.Companyname,
+			ClientStatus:    c.Status,
+			DaysSinceBilled: -1,
+		}
+
+		i, ok := last[c.ID]
+		if ok {
+			lb.InvoiceID = i.ID
+			lb.Date = i.Date.Format("2006-01-02")
+			lb.Total = i.Total
+			lb.Currency = i.Currencycode
+			lb.Status = i.Status
+			lb.DaysSinceBilled = int(now.Sub(i.Date.Time).Hours() / 24)
+		}
+
+		if len(opts.Currency) > 0 && lb.Currency != opts.Currency {
+			continue
+		}
+		billed = append(billed, lb)
+	}
+
+	return billed, nil
+}
 
-		if c.Status == status {
-			cl := ClientLastBilledList{}
-			clientid := fmt.Sprintf("%d", c.ID)
-			clParams := map[string]string{"clientid": clientid}
+// lastInvoicePerClient requests the newest invoices of each client until one that bills the client is found
+func (s *AccountsService) lastInvoicePerClient(clients []WHMCSclient) (map[int]Invoice, error) {
+	ids := make([]int, len(clients))
+	for i, c := range clients {
+		ids[i] = c.ID
+	}
 
-			resp, err := apiRequest(s.client, Params{parms: clParams, u: "GetClientsDetails"}, cl)
-			if err != nil {
-				fmt.Println(err)
+	found := make([]*Invoice, len(ids))
+	err := EnrichClients(ids, defaultConcurrency, func(n, clientID int) error {
+		parms := map[string]string{"userid": fmt.Sprintf("%d", clientID), "orderby": "date", "order": "desc"}
+		err := s.client.Billing.EachInvoice(parms, defaultPageSize, func(i Invoice) error {
+			if i.UserID != clientID || !billedBy(i) {
+				return nil
 			}
-			json.Unmarshal([]byte(resp.Body), &cl)
-			// cl.Phone = strings.Replace(cl.Phone, "+61.", "0", -1)
-			// cl.Phone = strings.Replace(cl.Phone, " ", "", -1)
-			// if len(cl.Phone) == 10 {
-			// 	cl.Phone = fmt.Sprintf("%s %s %s", cl.Phone[0:4], cl.Phone[4:7], cl.Phone[7:10])
-			// }
-
-			// cl.AlertPrimary = strings.Replace(cl.AlertPrimary, " ", "", -1)
-			// if len(cl.AlertPrimary) == 10 {
-			// 	cl.AlertPrimary = fmt.Sprintf("%s %s %s", cl.AlertPrimary[0:4], cl.AlertPrimary[4:7], cl.AlertPrimary[7:10])
-			// }
+			found[n] = &i
+			return errStopPaging
+		})
+		if err == errStopPaging {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			contactList = append(contactList, cl)
+	last := map[int]Invoice{}
+	for n, i := range found {
+		if i != nil {
+			last[ids[n]] = *i
 		}
 	}
+	return last, nil
+}
+
+// lastInvoiceScan reads every invoice newest first until the last invoice of each client has been found
+func (s *AccountsService) lastInvoiceScan(clients []WHMCSclient) (map[int]Invoice, error) {
+	wanted := make(map[int]bool, len(clients))
+	for _, c := range clients {
+		wanted[c.ID] = true
+	}
 
-	return contactList, nil
+	last := map[int]Invoice{}
+	parms := map[string]string{"orderby": "date", "order": "desc"}
+	err := s.client.Billing.EachInvoice(parms, defaultPageSize, func(i Invoice) error {
+		if !wanted[i.UserID] || !billedBy(i) {
+			return nil
+		}
+		if _, ok := last[i.UserID]; !ok {
+			last[i.UserID] = i
+		}
+		if len(last) == len(wanted) {
+			return errStopPaging
+		}
+		return nil
+	})
+	if err != nil && err != errStopPaging {
+		return nil, err
+	}
+	return last, nil
 }
@@ -2,10 +2,15 @@ package whmcsgo
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // RoundTripFunc .
@@ -83,3 +88,157 @@ func TestAccountsService_GetClients(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountsService_clientsWithStatus(t *testing.T) {
+	const total = 2600
+	var pages int
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		start, _ := strconv.Atoi(req.PostForm.Get("limitstart"))
+		num, _ := strconv.Atoi(req.PostForm.Get("limitnum"))
+		pages++
+
+		var clients []string
+		for id := start + 1; id <= start+num && id <= total; id++ {
+			status := "Active"
+			if id%2 == 0 {
+				status = "Inactive"
+			}
+			clients = append(clients, fmt.Sprintf(`{"id":%d,"status":"%s"}`, id, status))
+		}
+		body := fmt.Sprintf(`{"result":"success","totalresults":%d,"startnumber":%d,"numreturned":%d,"clients":{"client":[%s]}}`,
+			total, start, len(clients), strings.Join(clients, ","))
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := NewClient(tclient, Authentication{}, "defaultBaseURL string").Accounts
+
+	got, err := s.clientsWithStatus("Active")
+	if err != nil {
+		t.Fatalf("AccountsService.clientsWithStatus() error = %v", err)
+	}
+	if len(got) != total/2 || got[len(got)-1].ID != total-1 {
+		t.Errorf("AccountsService.clientsWithStatus() got %d clients, want %d", len(got), total/2)
+	}
+	if pages != (total+defaultPageSize-1)/defaultPageSize {
+		t.Errorf("AccountsService.clientsWithStatus() requested %d pages", pages)
+	}
+}
+
+func TestAccountsService_ClientLastBilledWithOptions(t *testing.T) {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		body := `{"result":"success","totalresults":3,"numreturned":3,"clients":{"client":[` +
+			`{"id":1,"companyname":"One","status":"Active"},{"id":2,"companyname":"Two","status":"Inactive"},` +
+			`{"id":3,"companyname":"Three","status":"Active"},{"id":4,"companyname":"Four","status":"Active"}]}}`
+		if req.PostForm.Get("action") == "GetInvoices" {
+			body = `{"result":"success","totalresults":5,"numreturned":5,"invoices":{"invoice":[` +
+				`{"id":15,"userid":1,"date":"2021-03-10","total":"9.00","status":"Draft","currencycode":"AUD"},` +
+				`{"id":14,"userid":2,"date":"2021-03-05","total":"8.00","status":"Paid","currencycode":"AUD"},` +
+				`{"id":13,"userid":1,"date":"2021-03-01","total":"7.00","status":"Unpaid","currencycode":"AUD"},` +
+				`{"id":12,"userid":3,"date":"2021-02-01","total":"6.00","status":"Paid","currencycode":"USD"},` +
+				`{"id":11,"userid":1,"date":"2021-01-01","total":"5.00","status":"Paid","currencycode":"AUD"}]}}`
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := NewClient(tclient, Authentication{}, "defaultBaseURL string").Accounts
+	now := time.Date(2021, 3, 11, 12, 0, 0, 0, time.UTC)
+
+	got, err := s.ClientLastBilledWithOptions(LastBilledOptions{ClientStatus: "Active", Now: now})
+	if err != nil {
+		t.Fatalf("AccountsService.ClientLastBilledWithOptions() error = %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("AccountsService.ClientLastBilledWithOptions() got %d clients, want 3", len(got))
+	}
+	if got[0].InvoiceID != 13 || got[0].Date != "2021-03-01" || got[0].Total != "7.00" || got[0].DaysSinceBilled != 10 {
+		t.Errorf("AccountsService.ClientLastBilledWithOptions() client 1 = %+v", got[0])
+	}
+	if got[2].ClientID != 4 || got[2].InvoiceID != 0 || got[2].DaysSinceBilled != -1 {
+		t.Errorf("AccountsService.ClientLastBilledWithOptions() client 4 = %+v", got[2])
+	}
+
+	got, err = s.ClientLastBilledWithOptions(LastBilledOptions{Currency: "USD", Now: now})
+	if err != nil || len(got) != 1 || got[0].ClientID != 3 {
+		t.Errorf("AccountsService.ClientLastBilledWithOptions() USD = %+v, %v", got, err)
+	}
+}
+
+func TestAccountsService_ClientLastBilledWithOptions_paging(t *testing.T) {
+	tests := []struct {
+		name    string
+		clients int
+		perUser bool
+	}{
+		{"per client", lastBilledPerClientMax, true},
+		{"scan", lastBilledPerClientMax + 5, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			pages := 0
+			tclient := NewTestClient(func(req *http.Request) *http.Response {
+				req.ParseForm()
+				var items []string
+				body := ""
+				switch req.PostForm.Get("action") {
+				case "GetClients":
+					for id := 1; id <= tt.clients; id++ {
+						items = append(items, fmt.Sprintf(`{"id":%d,"status":"Active"}`, id))
+					}
+					body = fmt.Sprintf(`{"result":"success","totalresults":%d,"numreturned":%d,"clients":{"client":[%s]}}`, tt.clients, tt.clients, strings.Join(items, ","))
+				case "GetInvoices":
+					mu.Lock()
+					pages++
+					mu.Unlock()
+					userID := req.PostForm.Get("userid")
+					if (userID != "") != tt.perUser {
+						t.Errorf("GetInvoices userid = %q", userID)
+					}
+					for id := 1; id <= tt.clients; id++ {
+						if userID == "" || userID == strconv.Itoa(id) {
+							items = append(items, fmt.Sprintf(`{"id":%d,"userid":%d,"date":"2021-03-01","status":"Paid"}`, 100+id, id))
+						}
+					}
+					body = fmt.Sprintf(`{"result":"success","totalresults":10000,"numreturned":%d,"invoices":{"invoice":[%s]}}`, len(items), strings.Join(items, ","))
+				}
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+					Header:     make(http.Header),
+				}
+			})
+
+			s := NewClient(tclient, Authentication{}, "defaultBaseURL string").Accounts
+			got, err := s.ClientLastBilledWithOptions(LastBilledOptions{Now: time.Date(2021, 3, 11, 0, 0, 0, 0, time.UTC)})
+			if err != nil {
+				t.Fatalf("AccountsService.ClientLastBilledWithOptions() error = %v", err)
+			}
+			if len(got) != tt.clients {
+				t.Fatalf("AccountsService.ClientLastBilledWithOptions() got %d clients, want %d", len(got), tt.clients)
+			}
+			for _, c := range got {
+				if c.InvoiceID != 100+c.ClientID {
+					t.Errorf("AccountsService.ClientLastBilledWithOptions() client %d invoice = %d", c.ClientID, c.InvoiceID)
+				}
+			}
+
+			want := 1
+			if tt.perUser {
+				want = tt.clients
+			}
+			if pages != want {
+				t.Errorf("AccountsService.ClientLastBilledWithOptions() requested %d invoice pages, want %d", pages, want)
+			}
+		})
+	}
+}
@@ -1,9 +1,214 @@
 package whmcsgo
 
-// AddonsService handles communication with the ... related
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// AddonsService handles communication with the client addon related
 // methods of the WHMCS API.
 //
 // WHMCS API docs: https://developers.whmcs.com/api/api-index/
 type AddonsService struct {
 	client *Client
 }
+
+// ClientAddon an addon purchased by a client for one of their services
+type ClientAddon struct {
+	ID              int       `json:"id"`
+	UserID          int       `json:"userid"`
+	OrderID         int       `json:"orderid"`
+	ServiceID       int       `json:"serviceid"`
+	AddonID         int       `json:"addonid"`
+	Name            string    `json:"name"`
+	SetupFee        string    `json:"setupfee"`
+	Recurring       string    `json:"recurring"` // The recurring amount
+	BillingCycle    string    `json:"billingcycle"`
+	Tax             string    `json:"tax"`
+	Status          string    `json:"status"`
+	RegDate         WHCMSdate `json:"regdate"`
+	NextDueDate     WHCMSdate `json:"nextduedate"`
+	NextInvoiceDate WHCMSdate `json:"nextinvoicedate"`
+	PaymentMethod   string    `json:"paymentmethod"`
+	Notes           string    `json:"notes"`
+}
+
+func (a ClientAddon) String() string {
+	return Stringify(a)
+}
+
+// ClientAddonsReply object from WHMCS
+type ClientAddonsReply struct {
+	Result       string      `json:"result"`
+	ServiceID    json.Number `json:"serviceid"`
+	ClientID     json.Number `json:"clientid"`
+	Totalresults int         `json:"totalresults"`
+	Addons       struct {
+		Addon []ClientAddon `json:"addon"`
+	} `json:"addons"`
+}
+
+/*
+GetClientsAddons Obtain the Client's Product Addons that match the provided criteria
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getclientsaddons/
+
+Request Parameters
+
+serviceid
+	int	The service id(s) to obtain addons for. Single or comma separated list	Optional
+clientid
+	int	The client ID to obtain addons for	Optional
+addonid
+	int	The predefined addon ID to filter by	Optional
+*/
+func (s *AddonsService) GetClientsAddons(parms map[string]string) (*ClientAddonsReply, *Response, error) {
+	r := new(ClientAddonsReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetClientsAddons"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// ClientAddonUpdate the fields of a client addon to update, only fields that
+// are set are sent to WHMCS
+type ClientAddonUpdate struct {
+	Status          *string    // The status to change the addon to
+	TerminationDate *time.Time // The termination date of the addon
+	AddonID         *int       // The predefined addon ID to associate
+	Name            *string    // The custom name of the addon
+	SetupFee        *float64   // The setup fee of the addon
+	Recurring       *float64   // The recurring amount of the addon
+	BillingCycle    *string    // The billing cycle of the addon
+	NextDueDate     *time.Time // The next due date of the addon
+	NextInvoiceDate *time.Time // The next invoice date of the addon
+	Notes           *string    // The admin notes for the addon
+	AutoRecalc      *bool      // Recalculate the recurring amount, ignores Recurring
+}
+
+/*
+UpdateClientAddon Updates a Client Addon
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/updateclientaddon/
+
+Request Parameters
+
+id
+	int	The ID of the client addon to update	Required
+status
+	string	The status to change the addon to	Optional
+terminationdate
+	\Carbon\Carbon	The termination date of the addon Y-m-d	Optional
+addonid
+	int	The predefined addon ID to associate	Optional
+name
+	string	The custom name of the addon	Optional
+setupfee
+	float	The setup fee of the addon	Optional
+recurring
+	float	The recurring amount of the addon	Optional
+billingcycle
+	string	The billing cycle of the addon	Optional
+nextduedate
+	\Carbon\Carbon	The next due date of the addon Y-m-d	Optional
+nextinvoicedate
+	\Carbon\Carbon	The next invoice date of the addon Y-m-d	Optional
+notes
+	string	The admin notes for the addon	Optional
+autorecalc
+	bool	Should the recurring amount be automatically recalculated	Optional
+*/
+func (s *AddonsService) UpdateClientAddon(id int, update ClientAddonUpdate) (*ActionReply, *Response, error) {
+	if id < 1 {
+		return nil, nil, errors.New("client addon ID required to update a client addon")
+	}
+
+	parms := map[string]string{"id": fmt.Sprintf("%d", id)}
+	layout := "2006-01-02"
+
+	if update.Status != nil {
+		parms["status"] = *update.Status
+	}
+	if update.TerminationDate != nil {
+		parms["terminationdate"] = update.TerminationDate.Format(layout)
+	}
+	if update.AddonID != nil {
+		parms["addonid"] = fmt.Sprintf("%d", *update.AddonID)
+	}
+	if update.Name != nil {
+		parms["name"] = *update.Name
+	}
+	if update.SetupFee != nil {
+		parms["setupfee"] = fmt.Sprintf("%.2f", *update.SetupFee)
+	}
+	if update.Recurring != nil {
+		parms["recurring"] = fmt.Sprintf("%.2f", *update.Recurring)
+	}
+	if update.BillingCycle != nil {
+		parms["billingcycle"] = *update.BillingCycle
+	}
+	if update.NextDueDate != nil {
+		parms["nextduedate"] = update.NextDueDate.Format(layout)
+	}
+	if update.NextInvoiceDate != nil {
+		parms["nextinvoicedate"] = update.NextInvoiceDate.Format(layout)
+	}
+	if update.Notes != nil {
+		parms["notes"] = *update.Notes
+	}
+	if update.AutoRecalc != nil {
+		parms["autorecalc"] = FormatBool(*update.AutoRecalc)
+	}
+
+	r := new(ActionReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "UpdateClientAddon"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+/*
+OrderAddon Places an addon only order for an existing service using AddOrder
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/addorder/
+
+Request Parameters
+
+clientid
+	int	The ID of the client to add the order for	Required
+paymentmethod
+	string	The payment method for the order in the system format	Required
+addonids
+	int[]	The predefined addon IDs to order	Required
+serviceids
+	int[]	The service IDs to order the addons for	Required
+*/
+func (s *AddonsService) OrderAddon(clientID, serviceID, addonID int, paymentMethod string) (*OrderReply, *Response, error) {
+	if clientID < 1 || serviceID < 1 || addonID < 1 {
+		return nil, nil, errors.New("client ID, service ID and addon ID required to order an addon")
+	}
+
+	return s.client.Orders.AddOrder(AddOrderRequest{
+		ClientID:      clientID,
+		PaymentMethod: paymentMethod,
+		Addons:        []OrderAddon{{AddonID: addonID, ServiceID: serviceID}},
+	})
+}
@@ -0,0 +1,126 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newAddonsTestService returns an AddonsService answering every request with body, storing the posted params in form
+func newAddonsTestService(form *url.Values, body string) *AddonsService {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		*form = req.PostForm
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	return NewClient(tclient, Authentication{}, "defaultBaseURL string").Addons
+}
+
+func TestAddonsService_GetClientsAddons(t *testing.T) {
+	var form url.Values
+	s := newAddonsTestService(&form, `{"result":"success","serviceid":"11","clientid":3,"totalresults":1,"addons":{"addon":[`+
+		`{"id":4,"userid":3,"serviceid":11,"addonid":2,"name":"Backups","recurring":"5.00","billingcycle":"Monthly","status":"Active","nextduedate":"2021-05-01"}]}}`)
+
+	got, _, err := s.GetClientsAddons(map[string]string{"serviceid": "11"})
+	if err != nil {
+		t.Fatalf("AddonsService.GetClientsAddons() error = %v", err)
+	}
+	if form.Get("serviceid") != "11" {
+		t.Errorf("AddonsService.GetClientsAddons() got params = %v", form)
+	}
+	if got.ServiceID.String() != "11" || len(got.Addons.Addon) != 1 {
+		t.Fatalf("AddonsService.GetClientsAddons() got = %+v", got)
+	}
+	a := got.Addons.Addon[0]
+	if a.ID != 4 || a.Name != "Backups" || a.Recurring != "5.00" || a.NextDueDate.Format("2006-01-02") != "2021-05-01" {
+		t.Errorf("AddonsService.GetClientsAddons() addon = %+v", a)
+	}
+}
+
+func TestAddonsService_UpdateClientAddon(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int
+		update  ClientAddonUpdate
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "Only set fields",
+			id:     4,
+			update: ClientAddonUpdate{Status: String("Suspended"), Notes: String("")},
+			want:   map[string]string{"action": "UpdateClientAddon", "id": "4", "status": "Suspended", "notes": ""},
+		},
+		{
+			name: "Pricing and dates",
+			id:   4,
+			update: ClientAddonUpdate{
+				Recurring:   Float64(7.5),
+				SetupFee:    Float64(0),
+				NextDueDate: func() *time.Time { d := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC); return &d }(),
+				AutoRecalc:  Bool(false),
+			},
+			want: map[string]string{"action": "UpdateClientAddon", "id": "4", "recurring": "7.50", "setupfee": "0.00", "nextduedate": "2021-06-01", "autorecalc": "0"},
+		},
+		{
+			name:    "No ID",
+			update:  ClientAddonUpdate{Status: String("Active")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var form url.Values
+			s := newAddonsTestService(&form, `{"result":"success"}`)
+
+			_, _, err := s.UpdateClientAddon(tt.id, tt.update)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddonsService.UpdateClientAddon() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got := map[string]string{}
+			for k := range form {
+				if k != "responsetype" {
+					got[k] = form.Get(k)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AddonsService.UpdateClientAddon() got params = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddonsService_OrderAddon(t *testing.T) {
+	var form url.Values
+	s := newAddonsTestService(&form, `{"result":"success","orderid":21,"addonids":"4"}`)
+
+	if _, _, err := s.OrderAddon(3, 11, 2, "banktransfer"); err != nil {
+		t.Fatalf("AddonsService.OrderAddon() error = %v", err)
+	}
+	want := map[string]string{"action": "AddOrder", "clientid": "3", "paymentmethod": "banktransfer", "addonids[0]": "2", "serviceids[0]": "11"}
+	for k, v := range want {
+		if form.Get(k) != v {
+			t.Errorf("AddonsService.OrderAddon() %s = %q, want %q", k, form.Get(k), v)
+		}
+	}
+
+	form = nil
+	if _, _, err := s.OrderAddon(3, 0, 2, "banktransfer"); err == nil {
+		t.Error("AddonsService.OrderAddon() expected an error without a service ID")
+	}
+	if form != nil {
+		t.Error("AddonsService.OrderAddon() sent a request without a service ID")
+	}
+}
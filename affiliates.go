@@ -1,9 +1,152 @@
 package whmcsgo
 
-// AffiliatesService handles .... related
+import (
+	"errors"
+	"fmt"
+)
+
+// AffiliatesService handles communication with the affiliate related
 // methods of the WHMCS API.
 //
 // WHMCS API docs: https://developers.whmcs.com/api/api-index/
 type AffiliatesService struct {
 	client *Client
 }
+
+// Affiliate represents a client that has been activated as an affiliate
+type Affiliate struct {
+	ID        int       `json:"id"`
+	ClientID  int       `json:"clientid"`
+	Date      WHCMSdate `json:"date"`
+	Visitors  int       `json:"visitors"`
+	PayType   string    `json:"paytype"`   // The commission type: percentage or fixedamount, empty for the system default
+	PayAmount string    `json:"payamount"` // The commission amount or percentage
+	OneTime   int       `json:"onetime"`   // 1 when commission is only paid on the first payment
+	Balance   string    `json:"balance"`   // The commission balance owed to the affiliate
+	Withdrawn string    `json:"withdrawn"` // The commission already withdrawn by the affiliate
+}
+
+func (a Affiliate) String() string {
+	return Stringify(a)
+}
+
+// AffiliatesReply object from WHMCS
+type AffiliatesReply struct {
+	Affiliates struct {
+		Affiliate []Affiliate `json:"affiliate"`
+	} `json:"affiliates"`
+	Numreturned  int    `json:"numreturned"`
+	Result       string `json:"result"`
+	Startnumber  int    `json:"startnumber"`
+	Totalresults int    `json:"totalresults"`
+}
+
+/*
+AffiliateActivate Activate affiliate referrals for a client
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/affiliateactivate/
+
+Request Parameters
+
+userid
+	int	The client ID to activate affiliate status for	Required
+*/
+func (s *AffiliatesService) AffiliateActivate(userID int) (*ActionReply, *Response, error) {
+	if userID < 1 {
+		return nil, nil, errors.New("user ID required to activate an affiliate")
+	}
+
+	r := new(ActionReply)
+	parms := map[string]string{"userid": fmt.Sprintf("%d", userID)}
+
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "AffiliateActivate"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+/*
+GetAffiliates Obtain an array of affiliates
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getaffiliates/
+
+Request Parameters
+
+limitstart
+	int	The offset for the returned affiliate data (default: 0)	Optional
+limitnum
+	int	The number of records to return (default: 25)	Optional
+userid
+	int	Obtain affiliate data for a specific client account	Optional
+visitors
+	int	Provide a specific visitors count to filter affiliates by	Optional
+paytype
+	string	Provide a specific paytype to filter affiliates by: percentage, fixedamount	Optional
+payamount
+	float	Provide a specific payamount to filter affiliates by	Optional
+onetime
+	bool	Provide a specific onetime value to filter affiliates by	Optional
+balance
+	float	Provide a specific balance amount to filter affiliates by	Optional
+withdrawn
+	float	Provide a specific withdrawn amount to filter affiliates by	Optional
+*/
+func (s *AffiliatesService) GetAffiliates(parms map[string]string) (*AffiliatesReply, *Response, error) {
+	r := new(AffiliatesReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetAffiliates"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+/*
+EachAffiliate walks every affiliate matching the GetAffiliates filters in
+parms, requesting pageSize records at a time, and calls fn for each one.
+Iteration stops at the first error returned by fn or by the API.
+*/
+func (s *AffiliatesService) EachAffiliate(parms map[string]string, pageSize int, fn func(Affiliate) error) error {
+	return paginate(pageSize, func(start, num int) (int, int, error) {
+		p := copyParms(parms)
+		p["limitstart"] = fmt.Sprintf("%d", start)
+		p["limitnum"] = fmt.Sprintf("%d", num)
+
+		r, _, err := s.GetAffiliates(p)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, a := range r.Affiliates.Affiliate {
+			if err := fn(a); err != nil {
+				return 0, 0, err
+			}
+		}
+		return len(r.Affiliates.Affiliate), r.Totalresults, nil
+	})
+}
+
+// GetAllAffiliates returns every affiliate matching the GetAffiliates filters in parms.
+func (s *AffiliatesService) GetAllAffiliates(parms map[string]string) ([]Affiliate, error) {
+	var affiliates []Affiliate
+	err := s.EachAffiliate(parms, defaultPageSize, func(a Affiliate) error {
+		affiliates = append(affiliates, a)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return affiliates, nil
+}
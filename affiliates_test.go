@@ -0,0 +1,69 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestAffiliatesService_GetAllAffiliates(t *testing.T) {
+	var starts []string
+
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		start := req.PostForm.Get("limitstart")
+		starts = append(starts, start)
+
+		body := `{"result":"success","totalresults":3,"startnumber":0,"numreturned":2,"affiliates":{"affiliate":[` +
+			`{"id":1,"clientid":10,"date":"2020-01-02","visitors":5,"paytype":"percentage","payamount":"10.00","onetime":0,"balance":"12.50","withdrawn":"0.00"},` +
+			`{"id":2,"clientid":11,"date":"2020-01-03","visitors":0,"paytype":"","payamount":"0.00","onetime":1,"balance":"0.00","withdrawn":"5.00"}]}}`
+		if start != "0" {
+			body = `{"result":"success","totalresults":3,"startnumber":2,"numreturned":1,"affiliates":{"affiliate":[` +
+				`{"id":3,"clientid":12,"date":"0000-00-00","visitors":1,"paytype":"fixedamount","payamount":"2.00","onetime":0,"balance":"2.00","withdrawn":"0.00"}]}}`
+		}
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &AffiliatesService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	got, err := s.GetAllAffiliates(map[string]string{"paytype": "percentage"})
+	if err != nil {
+		t.Fatalf("AffiliatesService.GetAllAffiliates() error = %v", err)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("AffiliatesService.GetAllAffiliates() got %d affiliates, want 3", len(got))
+	}
+	if got[0].Balance != "12.50" || got[2].ClientID != 12 {
+		t.Errorf("AffiliatesService.GetAllAffiliates() got = %v", got)
+	}
+	if fmt.Sprint(starts) != "[0 2]" {
+		t.Errorf("AffiliatesService.GetAllAffiliates() requested pages %v, want [0 2]", starts)
+	}
+}
+
+func TestAffiliatesService_AffiliateActivate(t *testing.T) {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"result":"error","message":"Client ID not found"}`)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &AffiliatesService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	if _, _, err := s.AffiliateActivate(99); err == nil {
+		t.Error("AffiliatesService.AffiliateActivate() expected an error for an error result")
+	}
+	if _, _, err := s.AffiliateActivate(0); err == nil {
+		t.Error("AffiliatesService.AffiliateActivate() expected an error for a missing user ID")
+	}
+}
@@ -57,34 +57,34 @@ type Invoices struct {
 
 // Invoice from WHCMS
 type Invoice struct {
-	Companyname        string      `json:"companyname"`
-	UserID             int         `json:"userid"`
-	CreatedAt          WHCMSdate   `json:"created_at"`
-	Credit             string      `json:"credit"`
-	Currencycode       string      `json:"currencycode"`
-	Currencyprefix     string      `json:"currencyprefix"`
-	Currencysuffix     string      `json:"currencysuffix"`
-	Date               WHCMSdate   `json:"date"`
-	DateCancelled      WHCMSdate   `json:"date_cancelled"`
-	DateRefunded       WHCMSdate   `json:"date_refunded"`
-	Datepaid           WHCMSdate   `json:"datepaid"`
-	Duedate            WHCMSdate   `json:"duedate"`
-	Firstname          string      `json:"firstname"`
-	ID                 int         `json:"id"`
-	Invoicenum         string      `json:"invoicenum"`
-	LastCaptureAttempt WHCMSdate   `json:"last_capture_attempt"`
-	Lastname           string      `json:"lastname"`
-	Notes              string      `json:"notes"`
-	Paymentmethod      string      `json:"paymentmethod"`
-	Paymethodid        interface{} `json:"paymethodid"`
-	Status             string      `json:"status"`
-	Subtotal           string      `json:"subtotal"`
-	Tax                string      `json:"tax"`
-	Tax2               string      `json:"tax2"`
-	Taxrate            string      `json:"taxrate"`
-	Taxrate2           string      `json:"taxrate2"`
-	Total              string      `json:"total"`
-	UpdatedAt          WHCMSdate   `json:"updated_at"`
+	Companyname        string        `json:"companyname"`
+	UserID             int           `json:"userid"`
+	CreatedAt          WHCMSdate     `json:"created_at"`
+	Credit             string        `json:"credit"`
+	Currencycode       string        `json:"currencycode"`
+	Currencyprefix     string        `json:"currencyprefix"`
+	Currencysuffix     string        `json:"currencysuffix"`
+	Date               WHCMSdate     `json:"date"`
+	DateCancelled      WHCMSdate     `json:"date_cancelled"`
+	DateRefunded       WHCMSdate     `json:"date_refunded"`
+	Datepaid           WHCMSdate     `json:"datepaid"`
+	Duedate            WHCMSdate     `json:"duedate"`
+	Firstname          string        `json:"firstname"`
+	ID                 int           `json:"id"`
+	Invoicenum         string        `json:"invoicenum"`
+	LastCaptureAttempt WHCMSdate     `json:"last_capture_attempt"`
+	Lastname           string        `json:"lastname"`
+	Notes              string        `json:"notes"`
+	Paymentmethod      string        `json:"paymentmethod"`
+	Paymethodid        WHMCSint      `json:"paymethodid"` // The stored pay method, 0 when not set
+	Status             InvoiceStatus `json:"status"`
+	Subtotal           string        `json:"subtotal"`
+	Tax                string        `json:"tax"`
+	Tax2               string        `json:"tax2"`
+	Taxrate            string        `json:"taxrate"`
+	Taxrate2           string        `json:"taxrate2"`
+	Total              string        `json:"total"`
+	UpdatedAt          WHCMSdate     `json:"updated_at"`
 }
 
 func (i Invoices) String() string {
@@ -170,6 +170,35 @@ func (s *BillingService) GetInvoices(parms map[string]string) ([]Invoice, *Respo
 	return r, resp, err
 }
 
+/*
+EachInvoice walks every invoice matching the GetInvoices filters in parms,
+requesting pageSize records at a time, and calls fn for each one. Iteration
+stops at the first error returned by fn or by the API.
+*/
+func (s *BillingService) EachInvoice(parms map[string]string, pageSize int, fn func(Invoice) error) error {
+	return paginate(pageSize, func(start, num int) (int, int, error) {
+		p := copyParms(parms)
+		p["limitstart"] = fmt.Sprintf("%d", start)
+		p["limitnum"] = fmt.Sprintf("%d", num)
+
+		r := new(InvoicesReply)
+		resp, err := apiRequest(s.client, Params{parms: p, u: "GetInvoices"}, r)
+		if err != nil {
+			return 0, 0, err
+		}
+		if err = decodeResponse(resp, r); err != nil {
+			return 0, 0, err
+		}
+
+		for _, i := range r.Invoices.Invoice {
+			if err := fn(i); err != nil {
+				return 0, 0, err
+			}
+		}
+		return len(r.Invoices.Invoice), r.Totalresults, nil
+	})
+}
+
 // CaptureResult from a payment capture attempt
 // Possible error condition responses include:
 // Invoice Not Found or Not Unpaid
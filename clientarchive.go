@@ -0,0 +1,259 @@
+package whmcsgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ClientArchive everything WHMCS holds about a client, for data subject access requests
+type ClientArchive struct {
+	ClientID   int               `json:"clientid"`
+	ExportedAt time.Time         `json:"exported_at"`
+	Details    *ClientDetails    `json:"details"`
+	Contacts   []Contact         `json:"contacts"`
+	Services   []ClientProduct   `json:"services"`
+	Domains    []json.RawMessage `json:"domains"`
+	Invoices   []Invoice         `json:"invoices"`
+	Tickets    []json.RawMessage `json:"tickets"`
+	Emails     []Email           `json:"emails"`
+}
+
+// WriteJSON writes the archive as indented JSON
+func (a ClientArchive) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(a)
+}
+
+// fetchAllRaw requests every page of a list action and returns the raw items listed under outer.inner
+func (s *AccountsService) fetchAllRaw(action string, parms map[string]string, outer, inner string) ([]json.RawMessage, error) {
+	var items []json.RawMessage
+	err := paginate(defaultPageSize, func(start, num int) (int, int, error) {
+		p := copyParms(parms)
+		p["limitstart"] = fmt.Sprintf("%d", start)
+		p["limitnum"] = fmt.Sprintf("%d", num)
+
+		resp, err := apiRequest(s.client, Params{parms: p, u: action}, nil)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		reply := map[string]json.RawMessage{}
+		if err = decodeResponse(resp, &reply); err != nil {
+			return 0, 0, err
+		}
+
+		var total WHMCSint
+		json.Unmarshal(reply["totalresults"], &total)
+
+		var page []json.RawMessage
+		if list, ok := reply[outer]; ok && !isEmptyJSON(list) {
+			wrapped := map[string][]json.RawMessage{}
+			if err := json.Unmarshal(list, &wrapped); err != nil {
+				return 0, 0, fmt.Errorf("%s: %w", action, err)
+			}
+			page = wrapped[inner]
+		}
+
+		items = append(items, page...)
+		return len(page), int(total), nil
+	})
+	return items, err
+}
+
+/*
+ExportClient gathers the details, contacts, services, domains, invoices,
+tickets and emails of a client into one archive. Domains and tickets are kept
+as WHMCS returns them.
+*/
+func (s *AccountsService) ExportClient(clientID int) (*ClientArchive, error) {
+	if clientID < 1 {
+		return nil, errors.New("client ID required to export a client")
+	}
+
+	a := &ClientArchive{ClientID: clientID, ExportedAt: time.Now()}
+	id := fmt.Sprintf("%d", clientID)
+
+	details, _, err := s.LookupClient(ClientLookup{ClientID: clientID, Stats: true})
+	if err != nil {
+		return nil, fmt.Errorf("GetClientsDetails failed: %w", err)
+	}
+	a.Details = details
+
+	if a.Contacts, err = s.GetAllContacts(map[string]string{"userid": id}); err != nil {
+		return nil, fmt.Errorf("GetContacts failed: %w", err)
+	}
+
+	services, err := s.fetchAllRaw("GetClientsProducts", map[string]string{"clientid": id}, "products", "product")
+	if err != nil {
+		return nil, fmt.Errorf("GetClientsProducts failed: %w", err)
+	}
+	for _, raw := range services {
+		var p ClientProduct
+		if err := json.Unmarshal(raw, &p); err != nil {
+			return nil, fmt.Errorf("GetClientsProducts failed: %w", err)
+		}
+		a.Services = append(a.Services, p)
+	}
+
+	if a.Domains, err = s.fetchAllRaw("GetClientsDomains", map[string]string{"clientid": id}, "domains", "domain"); err != nil {
+		return nil, fmt.Errorf("GetClientsDomains failed: %w", err)
+	}
+
+	err = s.client.Billing.EachInvoice(map[string]string{"userid": id}, defaultPageSize, func(i Invoice) error {
+		a.Invoices = append(a.Invoices, i)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetInvoices failed: %w", err)
+	}
+
+	if a.Tickets, err = s.fetchAllRaw("GetTickets", map[string]string{"clientid": id, "ignore_dept_assignments": "1"}, "tickets", "ticket"); err != nil {
+		return nil, fmt.Errorf("GetTickets failed: %w", err)
+	}
+
+	if a.Emails, err = s.GetAllEmails(map[string]string{"clientid": id}); err != nil {
+		return nil, fmt.Errorf("GetEmails failed: %w", err)
+	}
+
+	return a, nil
+}
+
+/*
+ExportAndDeleteClient writes the archive of a client to w and only deletes the
+client once the archive has been written, for GDPR erasure requests.
+*/
+func (s *AccountsService) ExportAndDeleteClient(clientID int, w io.Writer, opts DeleteClientOptions) (*ClientArchive, error) {
+	a, err := s.ExportClient(clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.WriteJSON(w); err != nil {
+		return nil, fmt.Errorf("writing client archive failed: %w", err)
+	}
+
+	if _, _, err := s.DeleteClient(clientID, opts); err != nil {
+		return a, fmt.Errorf("DeleteClient failed: %w", err)
+	}
+	return a, nil
+}
+
+// MergeReport what MergeClients moved and what must be moved in the WHMCS admin area
+type MergeReport struct {
+	ContactIDs   []int   // The contacts created on the target client
+	Credit       float64 // The credit moved to the target client
+	ServiceIDs   []int   // Services still owned by the source client
+	DomainIDs    []int   // Domains still owned by the source client
+	InvoiceIDs   []int   // Invoices still owned by the source client
+	ClosedSource bool    // Whether the source client was closed
+}
+
+/*
+MergeClients merges the source client into the target client.
+
+The WHMCS API can not change the owner of services, domains or invoices, so
+these are listed in the report to be moved with the merge tool in the admin
+area. Contacts are copied to the target, skipping contacts whose email is
+already on the target so a failed merge can be run again, and the credit
+balance is moved. When closeSource is set the source client is closed once nothing remains to
+be moved by hand.
+*/
+func (s *AccountsService) MergeClients(sourceID, targetID int, closeSource bool) (*MergeReport, error) {
+	if sourceID < 1 || targetID < 1 || sourceID == targetID {
+		return nil, errors.New("two different client IDs required to merge clients")
+	}
+
+	source, err := s.ExportClient(sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	credit := 0.0
+	if c := strings.TrimSpace(source.Details.Client.Credit); c != "" {
+		if credit, err = strconv.ParseFloat(c, 64); err != nil {
+			return nil, fmt.Errorf("invalid credit balance %q of client %d: %w", c, sourceID, err)
+		}
+	}
+
+	existing, err := s.GetAllContacts(map[string]string{"userid": fmt.Sprintf("%d", targetID)})
+	if err != nil {
+		return nil, fmt.Errorf("GetContacts failed: %w", err)
+	}
+	emails := map[string]bool{}
+	for _, c := range existing {
+		emails[strings.ToLower(c.Email)] = true
+	}
+
+	report := &MergeReport{}
+	for _, c := range source.Contacts {
+		// Contacts copied by an earlier, partly failed merge are already on the target
+		if c.Email != "" && emails[strings.ToLower(c.Email)] {
+			continue
+		}
+
+		r, _, err := s.AddContact(targetID, ContactRequest{
+			FirstName:   String(c.FirstName),
+			LastName:    String(c.LastName),
+			CompanyName: String(c.CompanyName),
+			Email:       String(c.Email),
+			Address1:    String(c.Address1),
+			Address2:    String(c.Address2),
+			City:        String(c.City),
+			State:       String(c.State),
+			Postcode:    String(c.Postcode),
+			Country:     String(c.Country),
+			PhoneNumber: String(c.PhoneNumber),
+		})
+		if err != nil {
+			return report, fmt.Errorf("AddContact failed for contact %d: %w", c.ID, err)
+		}
+		report.ContactIDs = append(report.ContactIDs, r.ContactID)
+	}
+
+	if credit > 0 {
+		// Remove the credit from the source first so it never exists on both clients
+		desc := fmt.Sprintf("Credit moved to client %d", targetID)
+		if _, _, err := s.client.Billing.AddCredit(sourceID, desc, -credit, time.Time{}); err != nil {
+			return report, fmt.Errorf("AddCredit failed: %w", err)
+		}
+
+		desc = fmt.Sprintf("Credit moved from client %d", sourceID)
+		if _, _, err := s.client.Billing.AddCredit(targetID, desc, credit, time.Time{}); err != nil {
+			desc = fmt.Sprintf("Credit returned after failed move to client %d", targetID)
+			if _, _, rerr := s.client.Billing.AddCredit(sourceID, desc, credit, time.Time{}); rerr != nil {
+				return report, fmt.Errorf("AddCredit failed: %v, returning %.2f credit to client %d failed: %w", err, credit, sourceID, rerr)
+			}
+			return report, fmt.Errorf("AddCredit failed: %w", err)
+		}
+		report.Credit = credit
+	}
+
+	for _, p := range source.Services {
+		report.ServiceIDs = append(report.ServiceIDs, int(p.ID))
+	}
+	for _, raw := range source.Domains {
+		var d struct {
+			ID int `json:"id"`
+		}
+		json.Unmarshal(raw, &d)
+		report.DomainIDs = append(report.DomainIDs, d.ID)
+	}
+	for _, i := range source.Invoices {
+		report.InvoiceIDs = append(report.InvoiceIDs, i.ID)
+	}
+
+	if closeSource && len(report.ServiceIDs)+len(report.DomainIDs)+len(report.InvoiceIDs) == 0 {
+		if _, _, err := s.CloseClient(sourceID); err != nil {
+			return report, fmt.Errorf("CloseClient failed: %w", err)
+		}
+		report.ClosedSource = true
+	}
+
+	return report, nil
+}
@@ -0,0 +1,202 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+/*
+clientArchiveTestClient answers the list actions used by ExportClient for
+client 3 and records the actions requested. override can replace the body of
+any action, returning false to use the default body.
+*/
+func clientArchiveTestClient(actions *[]string, override func(action string, form url.Values) (string, bool)) *Client {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		action := req.PostForm.Get("action")
+		*actions = append(*actions, action)
+
+		body := `{"result":"success","totalresults":0,"numreturned":0}`
+		switch action {
+		case "GetClientsDetails":
+			body = `{"result":"success","client":{"id":3,"firstname":"Jo","credit":"12.50"},"stats":{"numpaidinvoices":1}}`
+		case "GetContacts":
+			if req.PostForm.Get("userid") != "3" {
+				break
+			}
+			body = `{"result":"success","totalresults":1,"numreturned":1,"contacts":{"contact":[{"id":7,"userid":3,"firstname":"Sam","email":"sam@example.com"}]}}`
+		case "GetClientsProducts":
+			body = `{"result":"success","totalresults":1,"numreturned":1,"products":{"product":[{"id":11,"clientid":3}]}}`
+		case "GetClientsDomains":
+			body = `{"result":"success","totalresults":1,"numreturned":1,"domains":{"domain":[{"id":21,"userid":3,"domainname":"example.com"}]}}`
+		case "GetInvoices":
+			body = `{"result":"success","totalresults":1,"numreturned":1,"invoices":{"invoice":[{"id":31,"userid":3,"status":"Paid"}]}}`
+		case "GetTickets":
+			body = `{"result":"success","totalresults":0,"numreturned":0,"tickets":""}`
+		case "GetEmails":
+			body = `{"result":"success","totalresults":1,"numreturned":1,"emails":{"email":[{"id":41,"userid":3,"subject":"Welcome"}]}}`
+		case "AddContact":
+			body = `{"result":"success","contactid":8}`
+		case "AddCredit":
+			body = `{"result":"success","newbalance":"12.50"}`
+		case "DeleteClient":
+			body = `{"result":"success","clientid":3}`
+		}
+		if override != nil {
+			if b, ok := override(action, req.PostForm); ok {
+				body = b
+			}
+		}
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	return NewClient(tclient, Authentication{}, "defaultBaseURL string")
+}
+
+func TestAccountsService_ExportAndDeleteClient(t *testing.T) {
+	var actions []string
+	c := clientArchiveTestClient(&actions, nil)
+
+	var buf bytes.Buffer
+	a, err := c.Accounts.ExportAndDeleteClient(3, &buf, DeleteClientOptions{DeleteUsers: true})
+	if err != nil {
+		t.Fatalf("AccountsService.ExportAndDeleteClient() error = %v", err)
+	}
+
+	if len(a.Contacts) != 1 || len(a.Services) != 1 || len(a.Domains) != 1 || len(a.Invoices) != 1 || len(a.Tickets) != 0 || len(a.Emails) != 1 {
+		t.Errorf("AccountsService.ExportAndDeleteClient() got archive = %+v", a)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(`"example.com"`)) {
+		t.Errorf("AccountsService.ExportAndDeleteClient() archive not written, got %s", buf.String())
+	}
+	if actions[len(actions)-1] != "DeleteClient" {
+		t.Errorf("AccountsService.ExportAndDeleteClient() last action = %s, want DeleteClient", actions[len(actions)-1])
+	}
+}
+
+func TestAccountsService_ExportAndDeleteClient_writeFails(t *testing.T) {
+	var actions []string
+	c := clientArchiveTestClient(&actions, nil)
+
+	if _, err := c.Accounts.ExportAndDeleteClient(3, failingWriter{}, DeleteClientOptions{}); err == nil {
+		t.Fatal("AccountsService.ExportAndDeleteClient() expected an error")
+	}
+	for _, action := range actions {
+		if action == "DeleteClient" {
+			t.Fatal("AccountsService.ExportAndDeleteClient() deleted the client without writing the archive")
+		}
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestAccountsService_MergeClients(t *testing.T) {
+	var actions []string
+	c := clientArchiveTestClient(&actions, nil)
+
+	got, err := c.Accounts.MergeClients(3, 4, true)
+	if err != nil {
+		t.Fatalf("AccountsService.MergeClients() error = %v", err)
+	}
+
+	if len(got.ContactIDs) != 1 || got.ContactIDs[0] != 8 {
+		t.Errorf("AccountsService.MergeClients() ContactIDs = %v, want [8]", got.ContactIDs)
+	}
+	if got.Credit != 12.5 {
+		t.Errorf("AccountsService.MergeClients() Credit = %v, want 12.5", got.Credit)
+	}
+	if len(got.ServiceIDs) != 1 || got.ServiceIDs[0] != 11 || len(got.DomainIDs) != 1 || got.DomainIDs[0] != 21 || len(got.InvoiceIDs) != 1 || got.InvoiceIDs[0] != 31 {
+		t.Errorf("AccountsService.MergeClients() got report = %+v", got)
+	}
+	if got.ClosedSource {
+		t.Error("AccountsService.MergeClients() closed the source client while it still owns services")
+	}
+
+	if _, err := c.Accounts.MergeClients(3, 3, false); err == nil {
+		t.Error("AccountsService.MergeClients() expected an error merging a client into itself")
+	}
+}
+
+func TestAccountsService_MergeClients_credit(t *testing.T) {
+	var credits []string
+	var actions []string
+	c := clientArchiveTestClient(&actions, func(action string, form url.Values) (string, bool) {
+		if action != "AddCredit" {
+			return "", false
+		}
+		credits = append(credits, form.Get("clientid")+":"+form.Get("type")+":"+form.Get("amount"))
+		if form.Get("clientid") == "4" {
+			return `{"result":"error","message":"Client ID Not Found"}`, true
+		}
+		return "", false
+	})
+
+	if _, err := c.Accounts.MergeClients(3, 4, false); err == nil {
+		t.Fatal("AccountsService.MergeClients() expected an error when crediting the target fails")
+	}
+
+	want := []string{"3:remove:12.50", "4:add:12.50", "3:add:12.50"}
+	if len(credits) != len(want) {
+		t.Fatalf("AccountsService.MergeClients() AddCredit calls = %v, want %v", credits, want)
+	}
+	for i := range want {
+		if credits[i] != want[i] {
+			t.Errorf("AccountsService.MergeClients() AddCredit calls = %v, want %v", credits, want)
+			break
+		}
+	}
+}
+
+func TestAccountsService_MergeClients_rerun(t *testing.T) {
+	var actions []string
+	c := clientArchiveTestClient(&actions, func(action string, form url.Values) (string, bool) {
+		if action == "GetContacts" && form.Get("userid") == "4" {
+			return `{"result":"success","totalresults":1,"numreturned":1,"contacts":{"contact":[{"id":8,"userid":4,"email":"SAM@example.com"}]}}`, true
+		}
+		return "", false
+	})
+
+	got, err := c.Accounts.MergeClients(3, 4, false)
+	if err != nil {
+		t.Fatalf("AccountsService.MergeClients() error = %v", err)
+	}
+	if len(got.ContactIDs) != 0 {
+		t.Errorf("AccountsService.MergeClients() copied contacts already on the target, got %v", got.ContactIDs)
+	}
+	for _, action := range actions {
+		if action == "AddContact" {
+			t.Error("AccountsService.MergeClients() called AddContact for a contact already on the target")
+		}
+	}
+}
+
+func TestAccountsService_MergeClients_invalidCredit(t *testing.T) {
+	var actions []string
+	c := clientArchiveTestClient(&actions, func(action string, form url.Values) (string, bool) {
+		if action == "GetClientsDetails" {
+			return `{"result":"success","client":{"id":3,"credit":"twelve"}}`, true
+		}
+		return "", false
+	})
+
+	if _, err := c.Accounts.MergeClients(3, 4, false); err == nil {
+		t.Fatal("AccountsService.MergeClients() expected an error for an invalid credit balance")
+	}
+	for _, action := range actions {
+		if action == "AddContact" || action == "AddCredit" {
+			t.Errorf("AccountsService.MergeClients() called %s before rejecting the credit balance", action)
+		}
+	}
+}
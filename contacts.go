@@ -0,0 +1,315 @@
+package whmcsgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Contact a contact or sub-account of a client
+type Contact struct {
+	ID              int       `json:"id"`
+	UserID          int       `json:"userid"`
+	FirstName       string    `json:"firstname"`
+	LastName        string    `json:"lastname"`
+	CompanyName     string    `json:"companyname"`
+	Email           string    `json:"email"`
+	Address1        string    `json:"address1"`
+	Address2        string    `json:"address2"`
+	City            string    `json:"city"`
+	State           string    `json:"state"`
+	Postcode        string    `json:"postcode"`
+	Country         string    `json:"country"`
+	PhoneNumber     string    `json:"phonenumber"`
+	TaxID           string    `json:"tax_id"`
+	SubAccount      WHMCSbool `json:"subaccount"`
+	Permissions     string    `json:"permissions"`
+	GeneralEmails   WHMCSbool `json:"generalemails"`
+	ProductEmails   WHMCSbool `json:"productemails"`
+	DomainEmails    WHMCSbool `json:"domainemails"`
+	InvoiceEmails   WHMCSbool `json:"invoiceemails"`
+	SupportEmails   WHMCSbool `json:"supportemails"`
+	AffiliateEmails WHMCSbool `json:"affiliateemails"`
+}
+
+func (c Contact) String() string {
+	return Stringify(c)
+}
+
+// Contacts the contact list, WHMCS returns an empty string when there are no contacts
+type Contacts struct {
+	Contact []Contact `json:"contact"`
+}
+
+// UnmarshalJSON decodes the contacts list, accepting the empty values WHMCS sends
+func (c *Contacts) UnmarshalJSON(input []byte) error {
+	if isEmptyJSON(input) {
+		c.Contact = nil
+		return nil
+	}
+
+	type contacts Contacts
+	return json.Unmarshal(input, (*contacts)(c))
+}
+
+// ContactsReply object from WHMCS
+type ContactsReply struct {
+	Result       string   `json:"result"`
+	Totalresults int      `json:"totalresults"`
+	Startnumber  int      `json:"startnumber"`
+	Numreturned  int      `json:"numreturned"`
+	Contacts     Contacts `json:"contacts"`
+}
+
+// ContactReply the status after adding, updating or deleting a contact
+type ContactReply struct {
+	Result    string `json:"result"`
+	Message   string `json:"message"`
+	ContactID int    `json:"contactid"`
+}
+
+/*
+ContactRequest the contact details to add or update, only set fields are sent.
+Email preferences are sent as generalemails, productemails etc.
+*/
+type ContactRequest struct {
+	FirstName       *string
+	LastName        *string
+	CompanyName     *string
+	Email           *string
+	Address1        *string
+	Address2        *string
+	City            *string
+	State           *string
+	Postcode        *string
+	Country         *string // 2 character ISO country code
+	PhoneNumber     *string
+	TaxID           *string
+	Password        *string  // The password for a sub-account
+	Permissions     []string // The sub-account permissions, see the ClientPermission constants
+	SubAccount      *bool    // WHMCS 7 only, from WHMCS 8 sub-accounts are users
+	GeneralEmails   *bool
+	ProductEmails   *bool
+	DomainEmails    *bool
+	InvoiceEmails   *bool
+	SupportEmails   *bool
+	AffiliateEmails *bool
+}
+
+func (c ContactRequest) toParams() map[string]string {
+	parms := map[string]string{}
+
+	strs := []struct {
+		key string
+		v   *string
+	}{
+		{"firstname", c.FirstName},
+		{"lastname", c.LastName},
+		{"companyname", c.CompanyName},
+		{"email", c.Email},
+		{"address1", c.Address1},
+		{"address2", c.Address2},
+		{"city", c.City},
+		{"state", c.State},
+		{"postcode", c.Postcode},
+		{"country", c.Country},
+		{"phonenumber", c.PhoneNumber},
+		{"tax_id", c.TaxID},
+		{"password2", c.Password},
+	}
+	for _, s := range strs {
+		if s.v != nil {
+			parms[s.key] = *s.v
+		}
+	}
+
+	bools := []struct {
+		key string
+		v   *bool
+	}{
+		{"subaccount", c.SubAccount},
+		{"generalemails", c.GeneralEmails},
+		{"productemails", c.ProductEmails},
+		{"domainemails", c.DomainEmails},
+		{"invoiceemails", c.InvoiceEmails},
+		{"supportemails", c.SupportEmails},
+		{"affiliateemails", c.AffiliateEmails},
+	}
+	for _, b := range bools {
+		if b.v != nil {
+			parms[b.key] = FormatBool(*b.v)
+		}
+	}
+
+	if c.Permissions != nil {
+		parms["permissions"] = strings.Join(c.Permissions, ",")
+	}
+
+	return parms
+}
+
+/*
+GetContacts Obtain the Client Contacts that match passed criteria
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getcontacts/
+
+Request Parameters
+
+limitstart
+	The offset for the returned log data (default: 0) Optional
+limitnum
+	The number of records to return (default: 25) Optional
+userid
+	Find contacts for a specific client id Optional
+firstname
+	Find contacts with a specific first name Optional
+lastname
+	Find contacts with a specific last name Optional
+companyname
+	Find contacts with a specific company name Optional
+email
+	Find contacts with a specific email address Optional
+address1
+	Find contacts with a specific address line 1 Optional
+address2
+	Find contacts with a specific address line 2 Optional
+city
+	Find contacts with a specific city Optional
+state
+	Find contacts with a specific state Optional
+postcode
+	Find contacts with a specific post/zip code Optional
+country
+	Find contacts with a specific country Optional
+phonenumber
+	Find contacts with a specific phone number Optional
+subaccount
+	Search for sub-accounts Optional
+*/
+func (s *AccountsService) GetContacts(parms map[string]string) (*ContactsReply, *Response, error) {
+	r := new(ContactsReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetContacts"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+/*
+EachContact walks every contact matching the GetContacts filters in parms,
+requesting pageSize records at a time, and calls fn for each one. Iteration
+stops at the first error returned by fn or by the API.
+*/
+func (s *AccountsService) EachContact(parms map[string]string, pageSize int, fn func(Contact) error) error {
+	return paginate(pageSize, func(start, num int) (int, int, error) {
+		p := copyParms(parms)
+		p["limitstart"] = fmt.Sprintf("%d", start)
+		p["limitnum"] = fmt.Sprintf("%d", num)
+
+		r, _, err := s.GetContacts(p)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, c := range r.Contacts.Contact {
+			if err := fn(c); err != nil {
+				return 0, 0, err
+			}
+		}
+		return len(r.Contacts.Contact), r.Totalresults, nil
+	})
+}
+
+// GetAllContacts returns every contact matching the GetContacts filters in parms.
+func (s *AccountsService) GetAllContacts(parms map[string]string) ([]Contact, error) {
+	var contacts []Contact
+	err := s.EachContact(parms, defaultPageSize, func(c Contact) error {
+		contacts = append(contacts, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return contacts, nil
+}
+
+/*
+AddContact Adds a contact to a client account
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/addcontact/
+
+Request Parameters
+
+clientid
+	int	The ID of the client to add the contact to	Required
+
+Contact fields as per ContactRequest, see WHMCS API docs
+*/
+func (s *AccountsService) AddContact(clientID int, contact ContactRequest) (*ContactReply, *Response, error) {
+	if clientID < 1 {
+		return nil, nil, errors.New("client ID required to add a contact")
+	}
+
+	parms := contact.toParams()
+	parms["clientid"] = fmt.Sprintf("%d", clientID)
+
+	return s.contactAction("AddContact", parms)
+}
+
+/*
+UpdateContact Updates a contact, only the set fields of contact are changed
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/updatecontact/
+
+Request Parameters
+
+contactid
+	int	The ID of the contact to update	Required
+
+Contact fields as per ContactRequest, see WHMCS API docs
+*/
+func (s *AccountsService) UpdateContact(contactID int, contact ContactRequest) (*ContactReply, *Response, error) {
+	if contactID < 1 {
+		return nil, nil, errors.New("contact ID required to update a contact")
+	}
+
+	parms := contact.toParams()
+	parms["contactid"] = fmt.Sprintf("%d", contactID)
+
+	return s.contactAction("UpdateContact", parms)
+}
+
+// DeleteContact Deletes a contact
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/deletecontact/
+func (s *AccountsService) DeleteContact(contactID int) (*ContactReply, *Response, error) {
+	if contactID < 1 {
+		return nil, nil, errors.New("contact ID required to delete a contact")
+	}
+	return s.contactAction("DeleteContact", map[string]string{"contactid": fmt.Sprintf("%d", contactID)})
+}
+
+// contactAction sends a contact action and decodes the reply
+func (s *AccountsService) contactAction(action string, parms map[string]string) (*ContactReply, *Response, error) {
+	r := new(ContactReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: action}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
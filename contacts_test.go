@@ -0,0 +1,63 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestAccountsService_GetAllContacts(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{
+			name: "Contacts",
+			body: `{"result":"success","totalresults":2,"startnumber":0,"numreturned":2,"contacts":{"contact":[` +
+				`{"id":1,"userid":3,"firstname":"Jo","email":"jo@example.com","subaccount":0,"invoiceemails":1},` +
+				`{"id":2,"userid":3,"firstname":"Sam","email":"sam@example.com","subaccount":1,"permissions":"profile,invoices"}]}}`,
+			want: 2,
+		},
+		{
+			name: "No contacts",
+			body: `{"result":"success","totalresults":0,"startnumber":0,"numreturned":0,"contacts":""}`,
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tclient := NewTestClient(func(req *http.Request) *http.Response {
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(tt.body)),
+					Header:     make(http.Header),
+				}
+			})
+
+			s := &AccountsService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+			got, err := s.GetAllContacts(map[string]string{"userid": "3"})
+			if err != nil {
+				t.Fatalf("AccountsService.GetAllContacts() error = %v", err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("AccountsService.GetAllContacts() got %d contacts, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestContactRequest_toParams(t *testing.T) {
+	got := ContactRequest{
+		Email:         String("jo@example.com"),
+		Address2:      String(""),
+		InvoiceEmails: Bool(false),
+		Permissions:   []string{ClientPermissionProfile, ClientPermissionInvoices},
+	}.toParams()
+
+	if len(got) != 4 || got["address2"] != "" || got["invoiceemails"] != "0" || got["permissions"] != "profile,invoices" {
+		t.Errorf("ContactRequest.toParams() got = %v", got)
+	}
+}
@@ -0,0 +1,241 @@
+package whmcsgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+/*
+CustomFieldDefs maps custom field names to their IDs.
+
+WHMCS has no API call listing the client custom fields, so client definitions
+are supplied by the caller, for example from configuration. Product custom
+field definitions can be loaded with ProductCustomFieldDefs or taken from a
+service with ClientProduct.CustomFieldDefs.
+*/
+type CustomFieldDefs map[string]int
+
+// customFieldName returns the custom field name from a `whmcs:"customfield=Name"` tag
+func customFieldName(tag string) (string, bool) {
+	const prefix = "customfield="
+	if !strings.HasPrefix(tag, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(tag, prefix), true
+}
+
+/*
+DecodeCustomFields sets the fields of the struct pointed to by v that are
+tagged `whmcs:"customfield=Name"` from values, a map of custom field ID to
+value, resolving each name through defs. String, bool, int and float fields
+are supported. Custom fields without a value leave the field unchanged, and
+unexported tagged fields are an error as they can not be set.
+*/
+func DecodeCustomFields(values map[int]string, defs CustomFieldDefs, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("custom fields can only be decoded into a pointer to a struct")
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		name, ok := customFieldName(rt.Field(i).Tag.Get("whmcs"))
+		if !ok {
+			continue
+		}
+
+		id, ok := defs[name]
+		if !ok {
+			return fmt.Errorf("custom field %q is not defined", name)
+		}
+
+		if !rv.Field(i).CanSet() {
+			return fmt.Errorf("custom field %q: field %s is not exported", name, rt.Field(i).Name)
+		}
+
+		value, ok := values[id]
+		if !ok {
+			continue
+		}
+
+		if err := setCustomField(rv.Field(i), strings.TrimSpace(value)); err != nil {
+			return fmt.Errorf("custom field %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// setCustomField parses value into the field f
+func setCustomField(f reflect.Value, value string) error {
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(value)
+	case reflect.Bool:
+		var b WHMCSbool
+		b.UnmarshalJSON([]byte(value))
+		f.SetBool(bool(b))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if value == "" {
+			f.SetInt(0)
+			return nil
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		f.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		if value == "" {
+			f.SetFloat(0)
+			return nil
+		}
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		f.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported field type %s", f.Type())
+	}
+	return nil
+}
+
+/*
+EncodeCustomFields returns the custom field ID => value map for the fields of v
+tagged `whmcs:"customfield=Name"`, ready for ClientUpdate.CustomFields or
+ServiceUpdate.CustomFields. Bools are encoded as "on" or "" as WHMCS uses for
+tick box fields.
+*/
+func EncodeCustomFields(v interface{}, defs CustomFieldDefs) (map[int]string, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, errors.New("custom fields can only be encoded from a struct")
+	}
+	rt := rv.Type()
+
+	values := map[int]string{}
+	for i := 0; i < rt.NumField(); i++ {
+		name, ok := customFieldName(rt.Field(i).Tag.Get("whmcs"))
+		if !ok {
+			continue
+		}
+
+		id, ok := defs[name]
+		if !ok {
+			return nil, fmt.Errorf("custom field %q is not defined", name)
+		}
+
+		f := rv.Field(i)
+		switch f.Kind() {
+		case reflect.String:
+			values[id] = f.String()
+		case reflect.Bool:
+			values[id] = ""
+			if f.Bool() {
+				values[id] = "on"
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			values[id] = strconv.FormatInt(f.Int(), 10)
+		case reflect.Float32, reflect.Float64:
+			values[id] = strconv.FormatFloat(f.Float(), 'f', -1, 64)
+		default:
+			return nil, fmt.Errorf("custom field %q: unsupported field type %s", name, f.Type())
+		}
+	}
+	return values, nil
+}
+
+// Map returns the custom field ID => value pairs
+func (c ClientCustomFields) Map() map[int]string {
+	values := make(map[int]string, len(c))
+	for _, f := range c {
+		values[f.ID] = f.Value
+	}
+	return values
+}
+
+// CustomFieldDefs returns the custom field definitions of the product of the service
+func (p ClientProduct) CustomFieldDefs() CustomFieldDefs {
+	defs := CustomFieldDefs{}
+	for _, f := range p.Customfields.Customfield {
+		defs[f.Name] = int(f.ID)
+	}
+	return defs
+}
+
+// CustomFieldValues returns the custom field ID => value pairs of the service
+func (p ClientProduct) CustomFieldValues() map[int]string {
+	values := map[int]string{}
+	for _, f := range p.Customfields.Customfield {
+		values[int(f.ID)] = f.Value
+	}
+	return values
+}
+
+// ProductCustomFieldDefs returns the custom field definitions of a product
+func (s *ProductsService) ProductCustomFieldDefs(pid int) (CustomFieldDefs, error) {
+	r, _, err := s.GetProducts(map[string]string{"pid": fmt.Sprintf("%d", pid)})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range r.Products.Product {
+		if p.Pid != pid {
+			continue
+		}
+		defs := CustomFieldDefs{}
+		for _, f := range p.CustomFields.CustomField {
+			defs[f.Name] = f.ID
+		}
+		return defs, nil
+	}
+	return nil, fmt.Errorf("product %d not found", pid)
+}
+
+// GetClientCustomFields reads the custom fields of a client into the tagged fields of v
+func (s *AccountsService) GetClientCustomFields(clientID int, defs CustomFieldDefs, v interface{}) error {
+	r, _, err := s.LookupClient(ClientLookup{ClientID: clientID})
+	if err != nil {
+		return err
+	}
+	return DecodeCustomFields(r.Client.CustomFields.Map(), defs, v)
+}
+
+// UpdateClientCustomFields writes the tagged fields of v to the custom fields of a client
+func (s *AccountsService) UpdateClientCustomFields(clientID int, defs CustomFieldDefs, v interface{}) error {
+	values, err := EncodeCustomFields(v, defs)
+	if err != nil {
+		return err
+	}
+	_, _, err = s.UpdateClient(context.Background(), clientID, ClientUpdate{CustomFields: values})
+	return err
+}
+
+// GetServiceCustomFields reads the custom fields of a service into the tagged fields of v
+func (s *ServiceService) GetServiceCustomFields(serviceID int, v interface{}) error {
+	p, _, err := s.GetClientsProducts(serviceID)
+	if err != nil {
+		return err
+	}
+	return DecodeCustomFields(p.CustomFieldValues(), p.CustomFieldDefs(), v)
+}
+
+// UpdateServiceCustomFields writes the tagged fields of v to the custom fields of a service
+func (s *ServiceService) UpdateServiceCustomFields(serviceID int, v interface{}) error {
+	p, _, err := s.GetClientsProducts(serviceID)
+	if err != nil {
+		return err
+	}
+
+	values, err := EncodeCustomFields(v, p.CustomFieldDefs())
+	if err != nil {
+		return err
+	}
+	_, _, err = s.UpdateClientProduct(serviceID, ServiceUpdate{CustomFields: values})
+	return err
+}
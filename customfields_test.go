@@ -0,0 +1,58 @@
+package whmcsgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testClientFields struct {
+	Name     string
+	VMRef    string  `whmcs:"customfield=VM Reference"`
+	Vendor   string  `whmcs:"customfield=Vendor Software"`
+	Seats    int     `whmcs:"customfield=Seats"`
+	Rate     float64 `whmcs:"customfield=Rate"`
+	Reseller bool    `whmcs:"customfield=Reseller"`
+}
+
+func TestDecodeCustomFields(t *testing.T) {
+	defs := CustomFieldDefs{"VM Reference": 1, "Vendor Software": 3, "Seats": 4, "Rate": 6, "Reseller": 7}
+	values := map[int]string{1: "VM-12", 3: " Acme ", 4: "5", 6: "1.5", 7: "on"}
+
+	var got testClientFields
+	if err := DecodeCustomFields(values, defs, &got); err != nil {
+		t.Fatalf("DecodeCustomFields() error = %v", err)
+	}
+
+	want := testClientFields{VMRef: "VM-12", Vendor: "Acme", Seats: 5, Rate: 1.5, Reseller: true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeCustomFields() got = %v, want %v", got, want)
+	}
+
+	delete(defs, "Seats")
+	if err := DecodeCustomFields(values, defs, &got); err == nil {
+		t.Errorf("DecodeCustomFields() expected error for an undefined field")
+	}
+}
+
+func TestDecodeCustomFields_unexported(t *testing.T) {
+	var got struct {
+		ref string `whmcs:"customfield=VM Reference"`
+	}
+	if err := DecodeCustomFields(map[int]string{1: "VM-12"}, CustomFieldDefs{"VM Reference": 1}, &got); err == nil {
+		t.Errorf("DecodeCustomFields() expected error for an unexported field")
+	}
+}
+
+func TestEncodeCustomFields(t *testing.T) {
+	defs := CustomFieldDefs{"VM Reference": 1, "Vendor Software": 3, "Seats": 4, "Rate": 6, "Reseller": 7}
+
+	got, err := EncodeCustomFields(testClientFields{Name: "ignored", VMRef: "VM-12", Seats: 5, Rate: 1.5}, defs)
+	if err != nil {
+		t.Fatalf("EncodeCustomFields() error = %v", err)
+	}
+
+	want := map[int]string{1: "VM-12", 3: "", 4: "5", 6: "1.5", 7: ""}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EncodeCustomFields() got = %v, want %v", got, want)
+	}
+}
@@ -1,9 +1,93 @@
 package whmcsgo
 
-// DomainsService handles communication with the ... related
+import "strconv"
+
+// DomainsService handles communication with the domain related
 // methods of the WHMCS API.
 //
 // WHMCS API docs: https://developers.whmcs.com/api/api-index/
 type DomainsService struct {
 	client *Client
 }
+
+// TLDPricing the prices of a TLD keyed by the number of years
+type TLDPricing struct {
+	Categories []string          `json:"categories"`
+	Group      string            `json:"group"`
+	Register   map[string]string `json:"register"`
+	Transfer   map[string]string `json:"transfer"`
+	Renew      map[string]string `json:"renew"`
+	Addons     struct {
+		DNS       WHMCSbool `json:"dns"`
+		Email     WHMCSbool `json:"email"`
+		IDProtect WHMCSbool `json:"idprotect"`
+	} `json:"addons"`
+}
+
+// Price returns the price of a register, transfer or renew for years, ok is
+// false when the TLD can not be bought for that period
+func (p TLDPricing) Price(action string, years int) (price float64, ok bool) {
+	var prices map[string]string
+	switch action {
+	case "register":
+		prices = p.Register
+	case "transfer":
+		prices = p.Transfer
+	case "renew":
+		prices = p.Renew
+	default:
+		return 0, false
+	}
+
+	v, found := prices[strconv.Itoa(years)]
+	if !found {
+		return 0, false
+	}
+
+	price, err := strconv.ParseFloat(v, 64)
+	if err != nil || price < 0 {
+		return 0, false
+	}
+	return price, true
+}
+
+// TLDPricingReply object from WHMCS
+type TLDPricingReply struct {
+	Result   string `json:"result"`
+	Currency struct {
+		ID     int    `json:"id"`
+		Code   string `json:"code"`
+		Prefix string `json:"prefix"`
+		Suffix string `json:"suffix"`
+		Format int    `json:"format"`
+		Rate   string `json:"rate"`
+	} `json:"currency"`
+	Pricing map[string]TLDPricing `json:"pricing"` // Keyed by TLD without the leading dot
+}
+
+/*
+GetTLDPricing Retrieve TLD pricing
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/gettldpricing/
+
+Request Parameters
+
+currencyid
+	int	The currency ID to fetch pricing for	Optional
+clientid
+	int	The client ID to fetch pricing for. Pass in lieu of currencyid	Optional
+*/
+func (s *DomainsService) GetTLDPricing(parms map[string]string) (*TLDPricingReply, *Response, error) {
+	r := new(TLDPricingReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetTLDPricing"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
@@ -0,0 +1,292 @@
+package whmcsgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Email an email sent to a client
+type Email struct {
+	ID          int       `json:"id"`
+	UserID      int       `json:"userid"`
+	Subject     string    `json:"subject"`
+	Message     string    `json:"message"`
+	Date        WHCMSdate `json:"date"`
+	To          string    `json:"to"`
+	CC          string    `json:"cc"`
+	BCC         string    `json:"bcc"`
+	Attachments string    `json:"attachments"`
+}
+
+func (e Email) String() string {
+	return Stringify(e)
+}
+
+// Emails the email list, WHMCS returns an empty string when there are no emails
+type Emails struct {
+	Email []Email `json:"email"`
+}
+
+// UnmarshalJSON decodes the email list, accepting the empty values WHMCS sends
+func (e *Emails) UnmarshalJSON(input []byte) error {
+	if isEmptyJSON(input) {
+		e.Email = nil
+		return nil
+	}
+
+	type emails Emails
+	return json.Unmarshal(input, (*emails)(e))
+}
+
+// EmailsReply object from WHMCS
+type EmailsReply struct {
+	Result       string `json:"result"`
+	Totalresults int    `json:"totalresults"`
+	Startnumber  int    `json:"startnumber"`
+	Numreturned  int    `json:"numreturned"`
+	Emails       Emails `json:"emails"`
+}
+
+/*
+GetEmails Obtain a list of emails sent to a specific Client ID
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getemails/
+
+Request Parameters
+
+clientid
+	int	The Client ID to search for	Required
+limitstart
+	int	The offset for the returned log data (default: 0)	Optional
+limitnum
+	int	The number of records to return (default: 25)	Optional
+date
+	string	The date to search for	Optional
+subject
+	string	The subject to search for	Optional
+*/
+func (s *AccountsService) GetEmails(parms map[string]string) (*EmailsReply, *Response, error) {
+	r := new(EmailsReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetEmails"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// EachEmail calls fn for every email matching the GetEmails filters in parms, requesting pageSize emails at a time
+func (s *AccountsService) EachEmail(parms map[string]string, pageSize int, fn func(Email) error) error {
+	return paginate(pageSize, func(start, num int) (int, int, error) {
+		p := copyParms(parms)
+		p["limitstart"] = fmt.Sprintf("%d", start)
+		p["limitnum"] = fmt.Sprintf("%d", num)
+
+		r, _, err := s.GetEmails(p)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, e := range r.Emails.Email {
+			if err := fn(e); err != nil {
+				return 0, 0, err
+			}
+		}
+		return len(r.Emails.Email), r.Totalresults, nil
+	})
+}
+
+// GetAllEmails returns every email matching the GetEmails filters in parms.
+func (s *AccountsService) GetAllEmails(parms map[string]string) ([]Email, error) {
+	var emails []Email
+	err := s.EachEmail(parms, defaultPageSize, func(e Email) error {
+		emails = append(emails, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return emails, nil
+}
+
+// EmailFilter narrows the emails returned by GetClientEmails, only set fields are applied
+type EmailFilter struct {
+	Date    time.Time // Emails sent on this day, filtered by WHMCS
+	Subject string    // Emails with a subject containing this text, filtered by WHMCS
+	Since   time.Time // Emails sent at or after this time
+	Until   time.Time // Emails sent before this time
+}
+
+func (f EmailFilter) toParams() map[string]string {
+	parms := map[string]string{}
+	if !f.Date.IsZero() {
+		parms["date"] = f.Date.Format("2006-01-02")
+	}
+	if f.Subject != "" {
+		parms["subject"] = f.Subject
+	}
+	return parms
+}
+
+// match reports whether e was sent within the Since and Until range of the filter
+func (f EmailFilter) match(e Email) bool {
+	if !f.Since.IsZero() && e.Date.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !e.Date.Before(f.Until) {
+		return false
+	}
+	return true
+}
+
+/*
+GetClientEmails returns every email sent to a client that matches filter.
+WHMCS can only filter on a single day, so the Since and Until range is applied
+to each page as it is read.
+*/
+func (s *AccountsService) GetClientEmails(clientID int, filter EmailFilter) ([]Email, error) {
+	if clientID < 1 {
+		return nil, errors.New("client ID required to get emails")
+	}
+
+	parms := filter.toParams()
+	parms["clientid"] = fmt.Sprintf("%d", clientID)
+
+	var emails []Email
+	err := s.EachEmail(parms, defaultPageSize, func(e Email) error {
+		if filter.match(e) {
+			emails = append(emails, e)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return emails, nil
+}
+
+// Related entity types of a custom email
+const (
+	EmailTypeGeneral = "general" // The related ID is a client ID
+	EmailTypeProduct = "product" // The related ID is a service ID
+	EmailTypeDomain  = "domain"  // The related ID is a domain ID
+	EmailTypeInvoice = "invoice" // The related ID is an invoice ID
+)
+
+// SendEmailReply the status after sending an email
+type SendEmailReply struct {
+	Result  string `json:"result"`
+	Message string `json:"message"`
+}
+
+/*
+SendEmailRequest an email to send, either from the template named Template or
+a custom email with Type, Subject and Message. ID is the client, service,
+domain or invoice the email relates to, matching the type of the template or
+Type.
+*/
+type SendEmailRequest struct {
+	Template    string            // The name of the email template to send
+	ID          int               // The related client, service, domain or invoice ID
+	Type        string            // The related entity type of a custom email, see EmailType consts
+	Subject     string            // The subject of a custom email
+	Message     string            // The body of a custom email
+	MergeFields map[string]string // Extra merge fields available to the template or message
+}
+
+func (e SendEmailRequest) toParams() (map[string]string, error) {
+	if e.ID < 1 {
+		return nil, errors.New("related ID required to send an email")
+	}
+
+	parms := map[string]string{"id": fmt.Sprintf("%d", e.ID)}
+
+	if e.Template != "" {
+		if e.Type != "" || e.Subject != "" || e.Message != "" {
+			return nil, errors.New("a template email can not have a custom type, subject or message")
+		}
+		parms["messagename"] = e.Template
+	} else {
+		switch e.Type {
+		case EmailTypeGeneral, EmailTypeProduct, EmailTypeDomain, EmailTypeInvoice:
+		default:
+			return nil, fmt.Errorf("unsupported email type: %q", e.Type)
+		}
+		if strings.TrimSpace(e.Subject) == "" || strings.TrimSpace(e.Message) == "" {
+			return nil, errors.New("subject and message required to send a custom email")
+		}
+		parms["customtype"] = e.Type
+		parms["customsubject"] = e.Subject
+		parms["custommessage"] = e.Message
+	}
+
+	if len(e.MergeFields) > 0 {
+		parms["customvars"] = serializeMergeFields(e.MergeFields)
+	}
+
+	return parms, nil
+}
+
+// serializeMergeFields encodes merge field name => value pairs for the WHMCS API
+func serializeMergeFields(fields map[string]string) string {
+	names := make([]string, 0, len(fields))
+	for k := range fields {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	arr := make(phpArray, 0, len(names))
+	for _, k := range names {
+		arr = append(arr, phpPair{Key: k, Value: fields[k]})
+	}
+	return serializeBase64(arr)
+}
+
+/*
+SendEmail Send a client Email Notification
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/sendemail/
+
+Request Parameters
+
+messagename
+	string	The name of the client email template to send	Optional
+id
+	int	The related ID of the type of email template being sent	Required
+customtype
+	string	The type of custom email: general, product, domain, invoice	Optional
+customsubject
+	string	The subject of the custom email	Optional
+custommessage
+	string	The body of the custom email	Optional
+customvars
+	string	Base64 encoded serialized array of additional merge fields	Optional
+*/
+func (s *AccountsService) SendEmail(email SendEmailRequest) (*SendEmailReply, *Response, error) {
+	parms, err := email.toParams()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(SendEmailReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "SendEmail"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
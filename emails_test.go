@@ -0,0 +1,118 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAccountsService_GetClientEmails(t *testing.T) {
+	var pages int
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		if req.PostForm.Get("clientid") != "3" || req.PostForm.Get("subject") != "Invoice" {
+			t.Errorf("GetEmails got params = %v", req.PostForm)
+		}
+
+		body := `{"result":"success","totalresults":3,"startnumber":0,"numreturned":2,"emails":{"email":[` +
+			`{"id":1,"userid":3,"subject":"Invoice Created","date":"2026-01-05 10:00:00"},` +
+			`{"id":2,"userid":3,"subject":"Invoice Payment Reminder","date":"2026-02-05 10:00:00"}]}}`
+		if req.PostForm.Get("limitstart") != "0" {
+			body = `{"result":"success","totalresults":3,"startnumber":2,"numreturned":1,"emails":{"email":[` +
+				`{"id":3,"userid":3,"subject":"Invoice Payment Confirmation","date":"2026-03-05 10:00:00"}]}}`
+		}
+		pages++
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &AccountsService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	got, err := s.GetClientEmails(3, EmailFilter{
+		Subject: "Invoice",
+		Since:   time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("AccountsService.GetClientEmails() error = %v", err)
+	}
+	if pages != 2 {
+		t.Errorf("AccountsService.GetClientEmails() requested %d pages, want 2", pages)
+	}
+	if len(got) != 2 || got[0].ID != 2 || got[1].ID != 3 {
+		t.Errorf("AccountsService.GetClientEmails() got = %v", got)
+	}
+}
+
+func TestSendEmailRequest_toParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		email   SendEmailRequest
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "Template",
+			email: SendEmailRequest{Template: "Invoice Created", ID: 31},
+			want:  map[string]string{"id": "31", "messagename": "Invoice Created"},
+		},
+		{
+			name:  "Custom",
+			email: SendEmailRequest{ID: 11, Type: EmailTypeProduct, Subject: "Maintenance", Message: "Hi {$client_name}"},
+			want:  map[string]string{"id": "11", "customtype": "product", "customsubject": "Maintenance", "custommessage": "Hi {$client_name}"},
+		},
+		{
+			name:    "No ID",
+			email:   SendEmailRequest{Template: "Invoice Created"},
+			wantErr: true,
+		},
+		{
+			name:    "Template and custom subject",
+			email:   SendEmailRequest{Template: "Invoice Created", ID: 31, Subject: "Hi"},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown type",
+			email:   SendEmailRequest{ID: 3, Type: "ticket", Subject: "Hi", Message: "Hi"},
+			wantErr: true,
+		},
+		{
+			name:    "No message",
+			email:   SendEmailRequest{ID: 3, Type: EmailTypeGeneral, Subject: "Hi"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.email.toParams()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SendEmailRequest.toParams() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("SendEmailRequest.toParams() got = %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("SendEmailRequest.toParams() %s = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func Test_serializeMergeFields(t *testing.T) {
+	got, _ := base64.StdEncoding.DecodeString(serializeMergeFields(map[string]string{"reason": "upgrade", "date": "1 March"}))
+	want := `a:2:{s:4:"date";s:7:"1 March";s:6:"reason";s:7:"upgrade";}`
+	if string(got) != want {
+		t.Errorf("serializeMergeFields() got = %s, want %s", got, want)
+	}
+}
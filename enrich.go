@@ -0,0 +1,113 @@
+package whmcsgo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// defaultConcurrency the number of requests run at once when enriching clients
+const defaultConcurrency = 8
+
+// ClientError an error returned while fetching the details of a single client
+type ClientError struct {
+	ClientID int
+	Err      error
+}
+
+func (e ClientError) Error() string {
+	return fmt.Sprintf("client %d: %v", e.ClientID, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e ClientError) Unwrap() error {
+	return e.Err
+}
+
+// ClientErrors the errors of every client that failed, in the order the clients were requested
+type ClientErrors []ClientError
+
+func (e ClientErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("%d clients failed: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// runConcurrent calls fn for every index below n, running at most workers calls at once
+func runConcurrent(n, workers int, fn func(i int)) {
+	if workers < 1 {
+		workers = defaultConcurrency
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+/*
+EnrichClients calls fn for every client ID, running at most concurrency calls
+at once, and returns the failures as ClientErrors in the order of clientIDs.
+
+fn is passed the index of the client ID so results can be stored in a slice
+allocated by the caller, keeping the order of clientIDs. Calls run in
+parallel, so fn must only write to the element at its own index.
+*/
+func EnrichClients(clientIDs []int, concurrency int, fn func(i, clientID int) error) error {
+	errs := make([]error, len(clientIDs))
+	runConcurrent(len(clientIDs), concurrency, func(i int) {
+		errs[i] = fn(i, clientIDs[i])
+	})
+
+	var failed ClientErrors
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, ClientError{ClientID: clientIDs[i], Err: err})
+		}
+	}
+	if len(failed) > 0 {
+		return failed
+	}
+	return nil
+}
+
+/*
+FetchClientsDetails requests GetClientsDetails for every client ID, running at
+most concurrency requests at once, and passes each response body to decode
+along with the index of the client ID. See EnrichClients for how ordering and
+errors are handled.
+*/
+func (s *AccountsService) FetchClientsDetails(clientIDs []int, concurrency int, decode func(i int, body []byte) error) error {
+	return EnrichClients(clientIDs, concurrency, func(i, clientID int) error {
+		parms := map[string]string{"clientid": fmt.Sprintf("%d", clientID)}
+
+		resp, err := apiRequest(s.client, Params{parms: parms, u: "GetClientsDetails"}, nil)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return errors.New("no response")
+		}
+
+		if err = decodeResponse(resp, nil); err != nil {
+			return err
+		}
+		return decode(i, []byte(resp.Body))
+	})
+}
@@ -0,0 +1,109 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEnrichClients(t *testing.T) {
+	ids := []int{5, 6, 7, 8, 9, 10}
+	got := make([]int, len(ids))
+	var running, peak int32
+
+	err := EnrichClients(ids, 2, func(i, clientID int) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+
+		if clientID%3 == 0 {
+			return errors.New("failed")
+		}
+		got[i] = clientID * 10
+		return nil
+	})
+
+	errs, ok := err.(ClientErrors)
+	if !ok || len(errs) != 2 || errs[0].ClientID != 6 || errs[1].ClientID != 9 {
+		t.Fatalf("EnrichClients() error = %v", err)
+	}
+	if fmt.Sprint(got) != "[50 0 70 80 0 100]" {
+		t.Errorf("EnrichClients() got = %v", got)
+	}
+	if peak > 2 {
+		t.Errorf("EnrichClients() ran %d calls at once, want at most 2", peak)
+	}
+}
+
+func TestAccountsService_ClientContactList(t *testing.T) {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		body := `{"result":"success","totalresults":3,"numreturned":3,"clients":{"client":[` +
+			`{"id":1,"companyname":"One","status":"Active"},{"id":2,"companyname":"Two","status":"Inactive"},` +
+			`{"id":3,"companyname":"Three","status":"Active"},{"id":4,"companyname":"Four","status":"Active"}]}}`
+		if req.PostForm.Get("action") == "GetClientsDetails" {
+			switch id := req.PostForm.Get("clientid"); id {
+			case "3":
+				body = `{"result":"error","message":"Client Not Found"}`
+			default:
+				body = `{"result":"success","userid":` + id + `,"companyname":"Company ` + id + `","status":"Active"}`
+			}
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &AccountsService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	got, err := s.ClientContactList("Active")
+
+	errs, ok := err.(ClientErrors)
+	if !ok || len(errs) != 1 || errs[0].ClientID != 3 {
+		t.Errorf("AccountsService.ClientContactList() error = %v", err)
+	}
+	if len(got) != 2 || got[0].UserID != 1 || got[1].UserID != 4 {
+		t.Errorf("AccountsService.ClientContactList() got = %v", got)
+	}
+}
+
+func TestAccountsService_ClientContactListWithOptions_customFields(t *testing.T) {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		body := `{"result":"success","totalresults":1,"numreturned":1,"clients":{"client":[{"id":1,"status":"Active"}]}}`
+		if req.PostForm.Get("action") == "GetClientsDetails" {
+			body = `{"result":"success","userid":1,"status":"Active","phonecc":61,"customfields1":"wrong",` +
+				`"client":{"id":1,"customfields":[{"id":7,"value":"VM-12"},{"id":8,"value":" Acme "},{"id":9,"value":"0412345678"}]}}`
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &AccountsService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	got, err := s.ClientContactListWithOptions("Active", ContactListOptions{
+		CustomFieldDefs: CustomFieldDefs{"VM Reference": 7, "Vendor Software": 8, "Alert Primary": 9},
+	})
+	if err != nil {
+		t.Fatalf("AccountsService.ClientContactListWithOptions() error = %v", err)
+	}
+	if len(got) != 1 || got[0].VMRef != "VM-12" || got[0].VendorSoftware != "Acme" || got[0].AlertPrimary != "0412 345 678" {
+		t.Errorf("AccountsService.ClientContactListWithOptions() got = %+v", got)
+	}
+}
@@ -6,5 +6,5 @@ require (
 	github.com/go-sql-driver/mysql v1.6.0
 	github.com/jinzhu/now v1.1.1
 	github.com/kelseyhightower/envconfig v1.4.0
-	github.com/stretchr/testify v1.7.0 // indirect
+	github.com/stretchr/testify v1.7.0
 )
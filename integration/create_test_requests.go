@@ -75,21 +75,16 @@ func createTestClient(whmcs *whmcsgo.Client) (*whmcsgo.Account, error) {
 }
 
 // Adds and accepts a test order for the given client
-func createTestOrder(whmcs *whmcsgo.Client, clientID, productID int, paymentMethod string) (*whmcsgo.Order, error) {
+func createTestOrder(whmcs *whmcsgo.Client, clientID, productID int, paymentMethod string) (*whmcsgo.OrderReply, error) {
 	// Add the order
-	order, resp, err := whmcs.Orders.AddOrder(map[string]string{
-		"clientid": fmt.Sprintf("%d", clientID), "paymentmethod": paymentMethod,
-		"pid": fmt.Sprintf("%d, 1", productID),
+	order, _, err := whmcs.Orders.AddOrder(whmcsgo.AddOrderRequest{
+		ClientID: clientID, PaymentMethod: paymentMethod,
+		Products: []whmcsgo.OrderProduct{{PID: productID}, {PID: 1}},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("whmcs.Orders.AddOrder failed: %w", err)
 	}
 
-	err = json.Unmarshal([]byte(resp.Body), order)
-	if err != nil {
-		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
-	}
-
 	if order.Result != "success" {
 		return nil, fmt.Errorf("order result invalid : %+v", order)
 	}
@@ -105,9 +100,9 @@ func createTestOrder(whmcs *whmcsgo.Client, clientID, productID int, paymentMeth
 }
 
 func deleteClient(whmcs *whmcsgo.Client, clientID int) error {
-	_, err := whmcs.Accounts.DeleteClient(map[string]string{
-		"clientid": fmt.Sprintf("%d", clientID), "deleteusers": "true",
-		"deletetransactions": "true",
+	_, _, err := whmcs.Accounts.DeleteClient(clientID, whmcsgo.DeleteClientOptions{
+		DeleteUsers:        true,
+		DeleteTransactions: true,
 	})
 	if err != nil {
 		return fmt.Errorf("whmcs.DeleteClient failed: %w", err)
@@ -201,6 +201,7 @@ func TestCreateInvoice(t *testing.T) {
 
 	// Create a new Invoice
 	invoice := whmcsgo.CreateInvoiceRequest{}
+	invoice.UserID = tc.ID
 	invoice.SendInvoice = false
 	invoice.Status = "Draft"
 	invoice.DueDate = now.EndOfMonth()
@@ -236,7 +237,7 @@ func TestCreateInvoice(t *testing.T) {
 		return true
 	}
 
-	invoiceid, supportInvoice, err := client.Billing.CreateInvoice(tc.ID, invoice)
+	invoiceid, supportInvoice, err := client.Billing.CreateInvoice(invoice)
 
 	assert.NoError(t, err)
 
@@ -259,7 +260,7 @@ func TestCreateInvoice(t *testing.T) {
 	lineItem.ItemAmount = 1
 	lineitems = append(lineitems, lineItem)
 
-	updInv, _, err := client.Billing.UpdateInvoice(invoiceid, lineitems)
+	updInv, _, err := client.Billing.UpdateInvoice(invoiceid, whmcsgo.UpdateInvoiceRequest{NewItems: lineitems})
 	assert.NoError(t, err)
 	assert.Equal(t, updInv.Result, "success")
 
@@ -0,0 +1,145 @@
+package whmcsgo
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// InvoiceStatus the status of an invoice
+type InvoiceStatus string
+
+// Invoice statuses used by Invoice.Status, GetInvoices, CreateInvoice and UpdateInvoice
+const (
+	InvoiceDraft          InvoiceStatus = "Draft"
+	InvoiceUnpaid         InvoiceStatus = "Unpaid"
+	InvoicePaid           InvoiceStatus = "Paid"
+	InvoiceCancelled      InvoiceStatus = "Cancelled"
+	InvoiceRefunded       InvoiceStatus = "Refunded"
+	InvoiceCollections    InvoiceStatus = "Collections"
+	InvoicePaymentPending InvoiceStatus = "Payment Pending"
+	InvoiceOverdue        InvoiceStatus = "Overdue" // Only a GetInvoices filter, overdue invoices are stored as Unpaid
+)
+
+// Valid reports whether the status is a status an invoice can be stored with
+func (s InvoiceStatus) Valid() bool {
+	_, ok := invoiceTransitions[s]
+	return ok
+}
+
+// invoiceTransitions the statuses an invoice can be moved to from each status
+var invoiceTransitions = map[InvoiceStatus][]InvoiceStatus{
+	InvoiceDraft:          {InvoiceUnpaid, InvoiceCancelled},
+	InvoiceUnpaid:         {InvoicePaid, InvoiceCancelled, InvoiceCollections, InvoicePaymentPending},
+	InvoicePaymentPending: {InvoicePaid, InvoiceUnpaid, InvoiceCancelled},
+	InvoiceCollections:    {InvoicePaid, InvoiceUnpaid, InvoiceCancelled},
+	InvoicePaid:           {InvoiceUnpaid, InvoiceRefunded},
+	InvoiceCancelled:      {InvoiceUnpaid},
+	InvoiceRefunded:       {},
+}
+
+/*
+ValidInvoiceTransition returns an error when an invoice can not be moved from
+one status to another, for example Paid to Draft. Overdue is treated as Unpaid
+and is never a valid target status.
+*/
+func ValidInvoiceTransition(from, to InvoiceStatus) error {
+	if from == InvoiceOverdue {
+		from = InvoiceUnpaid
+	}
+
+	allowed, ok := invoiceTransitions[from]
+	if !ok {
+		return fmt.Errorf("unknown invoice status: %s", from)
+	}
+	if !to.Valid() {
+		return fmt.Errorf("unsupported invoice status: %s", to)
+	}
+
+	for _, s := range allowed {
+		if s == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("invoice can not move from %s to %s", from, to)
+}
+
+/*
+SetInvoiceStatus moves an invoice to a new status, checking the transition
+against the current status of the invoice before calling UpdateInvoice. Other
+fields set in update are sent with the status. A draft moved to Unpaid is
+published instead, the publish options can only be set on a draft.
+*/
+func (s *BillingService) SetInvoiceStatus(invoiceID int, status InvoiceStatus, update UpdateInvoiceRequest) (*InvoiceReply, *Response, error) {
+	if invoiceID < 1 {
+		return nil, nil, errors.New("invoice ID required to change the invoice status")
+	}
+
+	invoice, resp, err := s.GetInvoice(invoiceID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err := ValidInvoiceTransition(invoice.Status, status); err != nil {
+		return nil, resp, err
+	}
+
+	publish := update.Publish || update.PublishAndSendEmail
+	if publish && invoice.Status != InvoiceDraft {
+		return nil, resp, fmt.Errorf("invoice %d is %s, only a draft invoice can be published", invoiceID, invoice.Status)
+	}
+
+	// Publishing a draft is done with publish rather than the status
+	if invoice.Status == InvoiceDraft && status == InvoiceUnpaid {
+		update.Status = ""
+		if !publish {
+			update.Publish = true
+		}
+	} else {
+		update.Status = status
+	}
+
+	return s.UpdateInvoice(invoiceID, update)
+}
+
+// PublishInvoice publishes a draft invoice, optionally emailing it to the client. Invoices that are not a draft return an error
+func (s *BillingService) PublishInvoice(invoiceID int, sendEmail bool) (*InvoiceReply, *Response, error) {
+	if invoiceID < 1 {
+		return nil, nil, errors.New("invoice ID required to publish an invoice")
+	}
+
+	invoice, resp, err := s.GetInvoice(invoiceID)
+	if err != nil {
+		return nil, resp, err
+	}
+	if invoice.Status != InvoiceDraft {
+		return nil, resp, fmt.Errorf("invoice %d is %s, only a draft invoice can be published", invoiceID, invoice.Status)
+	}
+
+	return s.UpdateInvoice(invoiceID, UpdateInvoiceRequest{
+		Publish:             !sendEmail,
+		PublishAndSendEmail: sendEmail,
+	})
+}
+
+// CancelInvoice marks an invoice as cancelled
+func (s *BillingService) CancelInvoice(invoiceID int) (*InvoiceReply, *Response, error) {
+	return s.SetInvoiceStatus(invoiceID, InvoiceCancelled, UpdateInvoiceRequest{})
+}
+
+// RefundInvoice marks a paid invoice as refunded. No money is returned through the payment gateway
+func (s *BillingService) RefundInvoice(invoiceID int) (*InvoiceReply, *Response, error) {
+	return s.SetInvoiceStatus(invoiceID, InvoiceRefunded, UpdateInvoiceRequest{})
+}
+
+/*
+MarkPaid marks an invoice as paid on the given date, the current date is used
+when datePaid is zero. No transaction is recorded, use AddInvoicePayment to
+record a payment against the invoice.
+*/
+func (s *BillingService) MarkPaid(invoiceID int, datePaid time.Time) (*InvoiceReply, *Response, error) {
+	if datePaid.IsZero() {
+		datePaid = time.Now()
+	}
+	return s.SetInvoiceStatus(invoiceID, InvoicePaid, UpdateInvoiceRequest{DatePaid: datePaid})
+}
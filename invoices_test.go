@@ -0,0 +1,112 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestValidInvoiceTransition(t *testing.T) {
+	tests := []struct {
+		from, to InvoiceStatus
+		wantErr  bool
+	}{
+		{from: InvoiceDraft, to: InvoiceUnpaid},
+		{from: InvoiceOverdue, to: InvoicePaid},
+		{from: InvoicePaid, to: InvoiceRefunded},
+		{from: InvoicePaid, to: InvoiceDraft, wantErr: true},
+		{from: InvoiceRefunded, to: InvoicePaid, wantErr: true},
+		{from: InvoiceUnpaid, to: InvoiceOverdue, wantErr: true},
+		{from: "Unknown", to: InvoicePaid, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" to "+string(tt.to), func(t *testing.T) {
+			if err := ValidInvoiceTransition(tt.from, tt.to); (err != nil) != tt.wantErr {
+				t.Errorf("ValidInvoiceTransition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInvoiceStatus_Valid(t *testing.T) {
+	for _, s := range []InvoiceStatus{InvoiceDraft, InvoiceUnpaid, InvoicePaid, InvoiceCancelled, InvoiceRefunded, InvoiceCollections, InvoicePaymentPending} {
+		if !s.Valid() {
+			t.Errorf("InvoiceStatus(%q).Valid() = false, want true", s)
+		}
+	}
+	for _, s := range []InvoiceStatus{InvoiceOverdue, "", "paid"} {
+		if s.Valid() {
+			t.Errorf("InvoiceStatus(%q).Valid() = true, want false", s)
+		}
+	}
+}
+
+func TestBillingService_PublishInvoice(t *testing.T) {
+	var updates []map[string]string
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		body := `{"result":"success","invoiceid":12,"status":"Draft"}`
+		if req.PostForm.Get("action") == "UpdateInvoice" {
+			updates = append(updates, map[string]string{
+				"status":              req.PostForm.Get("status"),
+				"publishandsendemail": req.PostForm.Get("publishandsendemail"),
+			})
+			body = `{"result":"success","invoiceid":12}`
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &BillingService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	if _, _, err := s.PublishInvoice(12, true); err != nil {
+		t.Fatalf("BillingService.PublishInvoice() error = %v", err)
+	}
+	if len(updates) != 1 || updates[0]["status"] != "" || updates[0]["publishandsendemail"] != FormatBool(true) {
+		t.Errorf("BillingService.PublishInvoice() updates = %v", updates)
+	}
+
+	if _, _, err := s.RefundInvoice(12); err == nil {
+		t.Errorf("BillingService.RefundInvoice() expected error refunding a draft")
+	}
+	if len(updates) != 1 {
+		t.Errorf("BillingService.RefundInvoice() called UpdateInvoice on an illegal transition")
+	}
+}
+
+func TestBillingService_PublishInvoice_notDraft(t *testing.T) {
+	for _, status := range []InvoiceStatus{InvoicePaid, InvoiceCancelled} {
+		t.Run(string(status), func(t *testing.T) {
+			updated := false
+			tclient := NewTestClient(func(req *http.Request) *http.Response {
+				req.ParseForm()
+				body := `{"result":"success","invoiceid":12,"status":"` + string(status) + `"}`
+				if req.PostForm.Get("action") == "UpdateInvoice" {
+					updated = true
+					body = `{"result":"success","invoiceid":12}`
+				}
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+					Header:     make(http.Header),
+				}
+			})
+
+			s := &BillingService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+			if _, _, err := s.PublishInvoice(12, false); err == nil {
+				t.Errorf("BillingService.PublishInvoice() expected error publishing a %s invoice", status)
+			}
+			if _, _, err := s.SetInvoiceStatus(12, InvoiceUnpaid, UpdateInvoiceRequest{Publish: true}); err == nil {
+				t.Errorf("BillingService.SetInvoiceStatus() expected error publishing a %s invoice", status)
+			}
+			if updated {
+				t.Errorf("BillingService.PublishInvoice() called UpdateInvoice for a %s invoice", status)
+			}
+		})
+	}
+}
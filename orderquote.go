@@ -0,0 +1,338 @@
+package whmcsgo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// QuoteOptions the currency and tax rules used to price an order
+type QuoteOptions struct {
+	CurrencyID  int     // The currency to price in when the order has no client ID
+	TaxRate     float64 // The first level tax rate as a percentage
+	TaxRate2    float64 // The second level tax rate as a percentage
+	CompoundTax bool    // Apply the second level tax on top of the first
+}
+
+// OrderQuoteLine the price of one product or domain on an order
+type OrderQuoteLine struct {
+	Type         string  // product or domain
+	Description  string  // The product name or domain being registered or transferred
+	BillingCycle string  // The billing cycle, or registration period for domains
+	SetupFee     float64 // The setup fee including configurable options
+	Recurring    float64 // The recurring price including configurable options
+	Discount     float64 // The discount from the promotion code
+	Amount       float64 // The amount due today, SetupFee + Recurring - Discount
+}
+
+// OrderQuote a line by line price breakdown of an order
+type OrderQuote struct {
+	Currency string // The currency code the order is priced in
+	Prefix   string
+	Suffix   string
+	Lines    []OrderQuoteLine
+	Subtotal float64 // The total of the lines after discounts
+	Discount float64 // The total discount from the promotion code
+	Tax      float64
+	Tax2     float64
+	Total    float64
+}
+
+// Format formats an amount with the currency prefix and suffix of the quote
+func (q OrderQuote) Format(amount float64) string {
+	return fmt.Sprintf("%s%.2f%s", q.Prefix, amount, q.Suffix)
+}
+
+// roundCents rounds an amount to two decimal places
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+/*
+QuoteOrder prices an order without creating anything in WHMCS.
+
+WHMCS has no dry-run mode for AddOrder, even with noinvoice and noemail an
+order is still created, so the total is calculated locally from the
+GetProducts pricing, GetTLDPricing, the promotion code from GetPromotions and
+the tax rules in opts. Tax is applied to every line. Addons can not be priced
+as their pricing is not available from the API.
+*/
+func (s *OrdersService) QuoteOrder(order AddOrderRequest, opts QuoteOptions) (*OrderQuote, error) {
+	if _, err := order.toParams(); err != nil {
+		return nil, err
+	}
+
+	if len(order.Addons) > 0 {
+		return nil, errors.New("addon pricing is not available to quote an order")
+	}
+
+	pricingParms := map[string]string{"clientid": fmt.Sprintf("%d", order.ClientID)}
+	if opts.CurrencyID > 0 {
+		pricingParms = map[string]string{"currencyid": fmt.Sprintf("%d", opts.CurrencyID)}
+	}
+	tlds, _, err := s.client.Domains.GetTLDPricing(pricingParms)
+	if err != nil {
+		return nil, fmt.Errorf("GetTLDPricing failed: %w", err)
+	}
+
+	quote := &OrderQuote{
+		Currency: tlds.Currency.Code,
+		Prefix:   tlds.Currency.Prefix,
+		Suffix:   tlds.Currency.Suffix,
+	}
+
+	var promo *Promotion
+	if len(order.PromoCode) > 0 {
+		promo, err = s.findPromotion(order.PromoCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(order.Products) > 0 {
+		lines, err := s.quoteProducts(order, quote.Currency, promo)
+		if err != nil {
+			return nil, err
+		}
+		quote.Lines = append(quote.Lines, lines...)
+	}
+
+	for _, d := range order.Domains {
+		line, err := quoteDomain(d, tlds)
+		if err != nil {
+			return nil, err
+		}
+		quote.Lines = append(quote.Lines, line)
+	}
+
+	for _, l := range quote.Lines {
+		quote.Subtotal += l.Amount
+		quote.Discount += l.Discount
+	}
+	quote.Subtotal = roundCents(quote.Subtotal)
+	quote.Discount = roundCents(quote.Discount)
+
+	quote.Tax = roundCents(quote.Subtotal * opts.TaxRate / 100)
+	taxable2 := quote.Subtotal
+	if opts.CompoundTax {
+		taxable2 += quote.Tax
+	}
+	quote.Tax2 = roundCents(taxable2 * opts.TaxRate2 / 100)
+	quote.Total = roundCents(quote.Subtotal + quote.Tax + quote.Tax2)
+
+	return quote, nil
+}
+
+// findPromotion looks up a promotion by its code
+func (s *OrdersService) findPromotion(code string) (*Promotion, error) {
+	r, _, err := s.GetPromotions(code)
+	if err != nil {
+		return nil, fmt.Errorf("GetPromotions failed: %w", err)
+	}
+
+	for i := range r.Promotions.Promotion {
+		if strings.EqualFold(r.Promotions.Promotion[i].Code, code) {
+			return &r.Promotions.Promotion[i], nil
+		}
+	}
+	return nil, fmt.Errorf("promotion %s not found", code)
+}
+
+// quoteProducts prices the products on the order in currency
+func (s *OrdersService) quoteProducts(order AddOrderRequest, currency string, promo *Promotion) ([]OrderQuoteLine, error) {
+	pids := make([]int, 0, len(order.Products))
+	for _, p := range order.Products {
+		pids = append(pids, p.PID)
+	}
+
+	r, _, err := s.client.Products.GetProducts(map[string]string{"pid": joinInts(pids)})
+	if err != nil {
+		return nil, fmt.Errorf("GetProducts failed: %w", err)
+	}
+
+	catalogue := map[int]Product{}
+	for _, p := range r.Products.Product {
+		catalogue[p.Pid] = p
+	}
+
+	lines := make([]OrderQuoteLine, 0, len(order.Products))
+	for _, op := range order.Products {
+		product, ok := catalogue[op.PID]
+		if !ok {
+			return nil, fmt.Errorf("product %d not found", op.PID)
+		}
+		if len(op.Addons) > 0 {
+			return nil, errors.New("addon pricing is not available to quote an order")
+		}
+
+		line, err := quoteProduct(product, op, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		if promo != nil && promo.Validate(product.Pid, line.BillingCycle, time.Now()) == nil {
+			line.Discount = promotionDiscount(*promo, line.SetupFee, line.Recurring)
+		}
+		line.Amount = roundCents(line.SetupFee + line.Recurring - line.Discount)
+
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// billingCycles in the order WHMCS offers them
+var billingCycles = []string{"Monthly", "Quarterly", "Semi-Annually", "Annually", "Biennially", "Triennially"}
+
+// quoteProduct prices a single product and its configurable options
+func quoteProduct(product Product, op OrderProduct, currency string) (OrderQuoteLine, error) {
+	line := OrderQuoteLine{Type: "product", Description: product.Name, BillingCycle: op.BillingCycle}
+
+	switch product.PayType {
+	case "free":
+		line.BillingCycle = "Free Account"
+		return line, nil
+	case "onetime":
+		line.BillingCycle = "One Time"
+	}
+
+	pricing, ok := product.Pricing[currency]
+	if !ok {
+		return line, fmt.Errorf("product %d has no pricing in %s", product.Pid, currency)
+	}
+
+	if len(line.BillingCycle) == 0 {
+		for _, c := range billingCycles {
+			if _, _, ok := pricing.Price(c); ok {
+				line.BillingCycle = c
+				break
+			}
+		}
+	}
+
+	setup, recurring, ok := pricing.Price(line.BillingCycle)
+	if !ok {
+		return line, fmt.Errorf("product %d is not offered with the %s billing cycle", product.Pid, line.BillingCycle)
+	}
+
+	for _, co := range product.ConfigOptions.ConfigOption {
+		selected, ok := op.ConfigOptions[co.ID]
+		if !ok {
+			continue
+		}
+
+		s, r, err := configOptionPrice(co, selected, currency, line.BillingCycle)
+		if err != nil {
+			return line, err
+		}
+		setup += s
+		recurring += r
+	}
+
+	if op.PriceOverride != nil {
+		recurring = *op.PriceOverride
+	}
+
+	line.SetupFee = roundCents(setup)
+	line.Recurring = roundCents(recurring)
+	return line, nil
+}
+
+// configOptionPrice prices the selected value of a configurable option.
+// Dropdown and radio options select an option ID, yes/no options are on when
+// 1 and quantity options multiply the price of their only value.
+func configOptionPrice(co ProductConfigOption, selected int, currency, cycle string) (setup, recurring float64, err error) {
+	values := co.Options.Option
+
+	var value *ProductConfigOptionValue
+	qty := 1.0
+
+	switch co.Type {
+	case "3":
+		if selected != 1 || len(values) == 0 {
+			return 0, 0, nil
+		}
+		value = &values[0]
+	case "4":
+		if len(values) == 0 {
+			return 0, 0, nil
+		}
+		value = &values[0]
+		qty = float64(selected)
+	default:
+		for i := range values {
+			if values[i].ID == selected {
+				value = &values[i]
+			}
+		}
+	}
+
+	if value == nil {
+		return 0, 0, fmt.Errorf("option %d not found for configurable option %s", selected, co.Name)
+	}
+
+	setup, recurring, ok := value.Pricing[currency].Price(cycle)
+	if !ok {
+		return 0, 0, nil
+	}
+	return setup * qty, recurring * qty, nil
+}
+
+// promotionDiscount the discount a promotion gives on the first payment
+func promotionDiscount(p Promotion, setup, recurring float64) float64 {
+	var value float64
+	fmt.Sscanf(p.Value, "%g", &value)
+
+	var discount float64
+	switch p.Type {
+	case PromoPercentage:
+		discount = (setup + recurring) * value / 100
+	case PromoFixedAmount:
+		discount = math.Min(value, setup+recurring)
+	case PromoPriceOverride:
+		discount = math.Max(recurring-value, 0)
+	case PromoFreeSetup:
+		discount = setup
+	}
+
+	return roundCents(discount)
+}
+
+// domainActions describes the domain types of an order
+var domainActions = map[string]string{"register": "Registration", "transfer": "Transfer"}
+
+// quoteDomain prices a domain registration or transfer
+func quoteDomain(d OrderDomain, tlds *TLDPricingReply) (OrderQuoteLine, error) {
+	years := d.RegPeriod
+	if years < 1 {
+		years = 1
+	}
+
+	line := OrderQuoteLine{
+		Type:         "domain",
+		Description:  fmt.Sprintf("Domain %s - %s", domainActions[d.DomainType], d.Domain),
+		BillingCycle: fmt.Sprintf("%d Year(s)", years),
+	}
+
+	dot := strings.Index(d.Domain, ".")
+	if dot < 0 {
+		return line, fmt.Errorf("invalid domain: %s", d.Domain)
+	}
+
+	tld := strings.ToLower(d.Domain[dot+1:])
+	pricing, ok := tlds.Pricing[tld]
+	if !ok {
+		return line, fmt.Errorf("no pricing found for .%s", tld)
+	}
+
+	price, ok := pricing.Price(d.DomainType, years)
+	if !ok {
+		return line, fmt.Errorf("no %s pricing found for .%s over %d year(s)", d.DomainType, tld, years)
+	}
+
+	line.Recurring = price
+	line.Amount = price
+	return line, nil
+}
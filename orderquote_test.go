@@ -0,0 +1,78 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestOrdersService_QuoteOrder(t *testing.T) {
+	bodies := map[string]string{
+		"GetTLDPricing": `{"result":"success","currency":{"id":1,"code":"AUD","prefix":"$","suffix":" AUD"},` +
+			`"pricing":{"com.au":{"register":{"1":"20.00","2":"40.00"},"transfer":{"1":"15.00"},"renew":{"1":"20.00"}}}}`,
+		"GetPromotions": `{"result":"success","totalresults":1,"promotions":{"promotion":[` +
+			`{"id":1,"code":"HALF","type":"Percentage","value":"50.00","cycles":"","appliesto":"1","maxuses":0,"uses":0}]}}`,
+		"GetProducts": `{"result":"success","totalresults":2,"products":{"product":[` +
+			`{"pid":1,"gid":1,"name":"Hosting","paytype":"recurring","pricing":{"AUD":{"msetupfee":"10.00","monthly":"20.00",` +
+			`"qsetupfee":"0.00","quarterly":"-1.00","ssetupfee":"0.00","semiannually":"-1.00","asetupfee":"0.00","annually":"200.00",` +
+			`"bsetupfee":"0.00","biennially":"-1.00","tsetupfee":"0.00","triennially":"-1.00"}},` +
+			`"configoptions":{"configoption":[{"id":7,"name":"Extra disk","type":"4","options":{"option":[` +
+			`{"id":70,"name":"GB","pricing":{"AUD":{"msetupfee":"0.00","monthly":"1.50","annually":"15.00"}}}]}}]}},` +
+			`{"pid":2,"gid":1,"name":"Backup","paytype":"recurring","pricing":{"AUD":{"msetupfee":"0.00","monthly":"5.00",` +
+			`"qsetupfee":"0.00","quarterly":"-1.00","ssetupfee":"0.00","semiannually":"-1.00","asetupfee":"0.00","annually":"-1.00",` +
+			`"bsetupfee":"0.00","biennially":"-1.00","tsetupfee":"0.00","triennially":"-1.00"}}}]}}`,
+	}
+
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		action := req.PostForm.Get("action")
+		body, ok := bodies[action]
+		if !ok {
+			t.Errorf("unexpected action %s", action)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &OrdersService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	quote, err := s.QuoteOrder(AddOrderRequest{
+		ClientID:      1,
+		PaymentMethod: "banktransfer",
+		PromoCode:     "HALF",
+		Products: []OrderProduct{
+			{PID: 1, Domain: "example.com.au", BillingCycle: "Monthly", ConfigOptions: map[int]int{7: 4}},
+			{PID: 2},
+		},
+		Domains: []OrderDomain{{Domain: "example.com.au", DomainType: "register", RegPeriod: 2}},
+	}, QuoteOptions{TaxRate: 10})
+	if err != nil {
+		t.Fatalf("OrdersService.QuoteOrder() error = %v", err)
+	}
+
+	if len(quote.Lines) != 3 {
+		t.Fatalf("OrdersService.QuoteOrder() got %d lines, want 3", len(quote.Lines))
+	}
+
+	hosting := quote.Lines[0]
+	if hosting.SetupFee != 10 || hosting.Recurring != 26 || hosting.Discount != 18 || hosting.Amount != 18 {
+		t.Errorf("hosting line got = %+v", hosting)
+	}
+	if quote.Lines[1].BillingCycle != "Monthly" || quote.Lines[1].Amount != 5 || quote.Lines[1].Discount != 0 {
+		t.Errorf("backup line got = %+v", quote.Lines[1])
+	}
+	if quote.Lines[2].Amount != 40 {
+		t.Errorf("domain line got = %+v", quote.Lines[2])
+	}
+
+	if quote.Subtotal != 63 || quote.Discount != 18 || quote.Tax != 6.3 || quote.Total != 69.3 {
+		t.Errorf("OrdersService.QuoteOrder() totals got = %+v", quote)
+	}
+	if quote.Format(quote.Total) != "$69.30 AUD" {
+		t.Errorf("OrderQuote.Format() got = %s", quote.Format(quote.Total))
+	}
+}
@@ -2,7 +2,9 @@ package whmcsgo
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 )
 
 // OrdersService provides access to the orders related functions
@@ -29,6 +31,16 @@ type Order struct {
 	HostName      *string `json:"hostname"`
 }
 
+// OrderReply the orders and items created by AddOrder
+type OrderReply struct {
+	Result     string `json:"result"`
+	OrderID    int    `json:"orderid"`
+	ServiceIDs string `json:"serviceids"` // Comma separated list of the service ids created
+	AddonIDs   string `json:"addonids"`   // Comma separated list of the addon ids created
+	DomainIDs  string `json:"domainids"`  // Comma separated list of the domain ids created
+	InvoiceID  int    `json:"invoiceid"`
+}
+
 type AcceptOrder struct {
 	Result string `json:"result"`
 }
@@ -37,16 +49,33 @@ func (o Order) String() string {
 	return Stringify(o)
 }
 
-// AddOrder adds an  new order
-//
-// WHMCs API docs: https://developers.whmcs.com/api-reference/addorder/
-func (s *OrdersService) AddOrder(parms map[string]string) (*Order, *Response, error) {
-	order := new(Order)
-	resp, err := apiRequest(s.client, Params{parms: parms, u: "AddOrder"}, order)
+/*
+AddOrder Adds an order to a client
+
+WHMCs API docs
+
+https://developers.whmcs.com/api-reference/addorder/
+
+Request Parameters
+
+see AddOrderRequest and the WHMCS API docs
+*/
+func (s *OrdersService) AddOrder(order AddOrderRequest) (*OrderReply, *Response, error) {
+	parms, err := order.toParams()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(OrderReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "AddOrder"}, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	return order, resp, err
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
 }
 
 // AcceptOrder accepts an existing order
@@ -73,30 +102,55 @@ func (s *OrdersService) AcceptOrder(parms map[string]string) (*AcceptOrder, erro
 	return &order, err
 }
 
-// GetOrders the orders for a user.  Passing the empty string will list
-// orders for the authenticated user.
-//
-// WHMCS API docs: https://developers.whmcs.com/api-reference/getorders/
-func (s *OrdersService) GetOrders(parms map[string]string) (*[]Order, *Response, error) {
-	orders := new([]Order)
-	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetOrders"}, orders)
+/*
+GetOrders Obtain orders matching the passed criteria
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getorders/
+
+Request Parameters
+
+limitstart
+	int	The offset for the returned order data (default: 0)	Optional
+limitnum
+	int	The number of records to return (default: 25)	Optional
+id
+	int	Obtain a specific order id	Optional
+userid
+	int	Find orders for a specific client id	Optional
+requestor_id
+	int	Find orders placed by a specific user id	Optional
+status
+	string	Find orders for a specific status	Optional
+*/
+func (s *OrdersService) GetOrders(parms map[string]string) (*OrdersReply, *Response, error) {
+	orders := new(OrdersReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetOrders"}, orders)
 	if err != nil {
 		return nil, resp, err
 	}
-	return orders, resp, err
+
+	if err = decodeResponse(resp, orders); err != nil {
+		return nil, resp, err
+	}
+	return orders, resp, nil
 }
 
-// GetOrderStatuses the status of the order
+// GetOrderStatuses the order statuses and the number of orders in each
 //
 // WHMCS API docs: https://developers.whmcs.com/api-reference/getorderstatuses/
-// TO-DO this shall return *[]OrderStatus
-func (s *OrdersService) GetOrderStatuses(parms map[string]string) (*Order, *Response, error) {
-	order := new(Order)
-	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetOrderStatuses"}, order)
+func (s *OrdersService) GetOrderStatuses() (*OrderStatusesReply, *Response, error) {
+	statuses := new(OrderStatusesReply)
+	resp, err := apiRequest(s.client, Params{parms: map[string]string{}, u: "GetOrderStatuses"}, statuses)
 	if err != nil {
 		return nil, resp, err
 	}
-	return order, resp, err
+
+	if err = decodeResponse(resp, statuses); err != nil {
+		return nil, resp, err
+	}
+	return statuses, resp, nil
 }
 
 // CancelOrder Cancel a Pending Order
@@ -110,3 +164,331 @@ func (s *OrdersService) CancelOrder(parms map[string]string) (*Order, *Response,
 	}
 	return order, resp, err
 }
+
+// orderAction sends an action that only takes the order ID and reports success
+func (s *OrdersService) orderAction(action string, orderID int, parms map[string]string) (*ActionReply, *Response, error) {
+	if orderID < 1 {
+		return nil, nil, fmt.Errorf("order ID required to run %s", action)
+	}
+
+	parms["orderid"] = fmt.Sprintf("%d", orderID)
+
+	r := new(ActionReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: action}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// PendingOrder Sets an order, and all associated order items to Pending status
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/pendingorder/
+func (s *OrdersService) PendingOrder(orderID int) (*ActionReply, *Response, error) {
+	return s.orderAction("PendingOrder", orderID, map[string]string{})
+}
+
+/*
+FraudOrder Marks an order as fraudulent
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/fraudorder/
+
+Request Parameters
+
+orderid
+	int	The Order ID to set as fraud	Required
+cancelsub
+	bool	Should the subscription be cancelled	Optional
+*/
+func (s *OrdersService) FraudOrder(orderID int, cancelSub bool) (*ActionReply, *Response, error) {
+	return s.orderAction("FraudOrder", orderID, map[string]string{"cancelsub": FormatBool(cancelSub)})
+}
+
+// DeleteOrder Removes an order from the system. Only Cancelled or Fraud orders can be deleted
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/deleteorder/
+func (s *OrdersService) DeleteOrder(orderID int) (*ActionReply, *Response, error) {
+	return s.orderAction("DeleteOrder", orderID, map[string]string{})
+}
+
+// FraudCheckReply the result of running a fraud check on an order
+type FraudCheckReply struct {
+	Result  string      `json:"result"`
+	Status  string      `json:"status"` // Fail when the order failed the fraud check
+	Module  string      `json:"module"`
+	Results interface{} `json:"results"` // The raw results, which differ per fraud module
+}
+
+/*
+OrderFraudCheck Run a fraud check on a passed Order ID using the active fraud module
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/orderfraudcheck/
+
+Request Parameters
+
+orderid
+	int	The order id to complete the fraud check on	Required
+ipaddress
+	string	To override the IP address on the fraud check	Optional
+*/
+func (s *OrdersService) OrderFraudCheck(orderID int, ipAddress string) (*FraudCheckReply, *Response, error) {
+	if orderID < 1 {
+		return nil, nil, errors.New("order ID required to run a fraud check")
+	}
+
+	parms := map[string]string{"orderid": fmt.Sprintf("%d", orderID)}
+	if len(ipAddress) > 0 {
+		parms["ipaddress"] = ipAddress
+	}
+
+	r := new(FraudCheckReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "OrderFraudCheck"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// OrdersReply object from WHMCS
+type OrdersReply struct {
+	Orders struct {
+		Order []OrderDetails `json:"order"`
+	} `json:"orders"`
+	Numreturned  int    `json:"numreturned"`
+	Result       string `json:"result"`
+	Startnumber  int    `json:"startnumber"`
+	Totalresults int    `json:"totalresults"`
+}
+
+// OrderDetails an order returned by GetOrders
+type OrderDetails struct {
+	ID                int       `json:"id"`
+	OrderNum          string    `json:"ordernum"`
+	UserID            int       `json:"userid"`
+	ContactID         int       `json:"contactid"`
+	RequestorID       int       `json:"requestor_id"`
+	Date              WHCMSdate `json:"date"`
+	Nameservers       string    `json:"nameservers"`
+	PromoCode         string    `json:"promocode"`
+	PromoType         string    `json:"promotype"`
+	PromoValue        string    `json:"promovalue"`
+	Amount            string    `json:"amount"`
+	PaymentMethod     string    `json:"paymentmethod"`
+	PaymentMethodName string    `json:"paymentmethodname"`
+	PaymentStatus     string    `json:"paymentstatus"`
+	InvoiceID         int       `json:"invoiceid"`
+	Status            string    `json:"status"`
+	IPAddress         string    `json:"ipaddress"`
+	FraudModule       string    `json:"fraudmodule"`
+	FraudOutput       string    `json:"fraudoutput"`
+	Notes             string    `json:"notes"`
+	Name              string    `json:"name"`
+	CurrencyPrefix    string    `json:"currencyprefix"`
+	CurrencySuffix    string    `json:"currencysuffix"`
+	LineItems         struct {
+		LineItem []OrderLineItem `json:"lineitem"`
+	} `json:"lineitems"`
+}
+
+func (o OrderDetails) String() string {
+	return Stringify(o)
+}
+
+// OrderLineItem a product, addon or domain on an order
+type OrderLineItem struct {
+	Type         string `json:"type"`  // product, addon, domain, upgrade or renewals
+	RelID        int    `json:"relid"` // The service, addon or domain ID
+	ProductType  string `json:"producttype"`
+	Product      string `json:"product"`
+	Domain       string `json:"domain"`
+	BillingCycle string `json:"billingcycle"`
+	Amount       string `json:"amount"`
+	Status       string `json:"status"`
+}
+
+// OrderStatus an order status and the number of orders with it
+type OrderStatus struct {
+	Title string `json:"title"`
+	Color string `json:"color"`
+	Count int    `json:"count"`
+}
+
+// OrderStatusesReply object from WHMCS
+type OrderStatusesReply struct {
+	Result       string `json:"result"`
+	Totalresults int    `json:"totalresults"`
+	Statuses     struct {
+		Status []OrderStatus `json:"status"`
+	} `json:"statuses"`
+}
+
+// AddOrderRequest the new order to be placed for a client
+type AddOrderRequest struct {
+	ClientID       int            // The ID of the client to add the order for
+	PaymentMethod  string         // The payment method for the order in system format
+	PromoCode      string         // The promotion code to apply to the order
+	PromoOverride  bool           // Apply the promotion even when not applicable
+	NoInvoice      bool           // Do not generate an invoice for the order
+	NoInvoiceEmail bool           // Do not send the Invoice Created email
+	NoEmail        bool           // Do not send the Order Confirmation email
+	ClientIP       string         // The IP address to record against the order
+	AffID          int            // The affiliate to credit with the order
+	Nameservers    []string       // Up to five nameservers for the domains on the order
+	Products       []OrderProduct // Products to order
+	Domains        []OrderDomain  // Domains to register or transfer, alone or with a product
+	Addons         []OrderAddon   // Addons to order for existing services
+}
+
+// OrderProduct a product on a new order
+type OrderProduct struct {
+	PID           int            // The product to order
+	Domain        string         // The domain for the product
+	BillingCycle  string         // The billing cycle eg monthly, annually
+	Addons        []int          // The predefined addon IDs to order with the product
+	ConfigOptions map[int]int    // Configurable option ID => option ID or quantity
+	CustomFields  map[int]string // Custom field ID => value
+	PriceOverride *float64       // Override the price of the product
+}
+
+// OrderDomain a domain registration or transfer on a new order
+type OrderDomain struct {
+	Domain          string // The domain name
+	DomainType      string // register or transfer
+	RegPeriod       int    // The number of years to register the domain for
+	EppCode         string // The EPP code for a transfer
+	IDProtection    bool   // Enable ID protection
+	DNSManagement   bool   // Enable DNS management
+	EmailForwarding bool   // Enable email forwarding
+}
+
+// OrderAddon an addon for an existing service on a new order
+type OrderAddon struct {
+	AddonID   int // The predefined addon ID
+	ServiceID int // The service to add the addon to
+}
+
+func (o AddOrderRequest) toParams() (map[string]string, error) {
+	if o.ClientID < 1 || len(o.PaymentMethod) == 0 {
+		return nil, errors.New("client ID and payment method required to add an order")
+	}
+	if len(o.Products) == 0 && len(o.Domains) == 0 && len(o.Addons) == 0 {
+		return nil, errors.New("no products, domains or addons for order found")
+	}
+	if len(o.Nameservers) > 5 {
+		return nil, errors.New("a maximum of five nameservers can be set on an order")
+	}
+
+	parms := map[string]string{
+		"clientid":      fmt.Sprintf("%d", o.ClientID),
+		"paymentmethod": o.PaymentMethod,
+	}
+
+	if len(o.PromoCode) > 0 {
+		parms["promocode"] = o.PromoCode
+	}
+	if o.PromoOverride {
+		parms["promooverride"] = FormatBool(o.PromoOverride)
+	}
+	if o.NoInvoice {
+		parms["noinvoice"] = FormatBool(o.NoInvoice)
+	}
+	if o.NoInvoiceEmail {
+		parms["noinvoiceemail"] = FormatBool(o.NoInvoiceEmail)
+	}
+	if o.NoEmail {
+		parms["noemail"] = FormatBool(o.NoEmail)
+	}
+	if len(o.ClientIP) > 0 {
+		parms["clientip"] = o.ClientIP
+	}
+	if o.AffID > 0 {
+		parms["affid"] = fmt.Sprintf("%d", o.AffID)
+	}
+	for i, ns := range o.Nameservers {
+		parms[fmt.Sprintf("nameserver%d", i+1)] = ns
+	}
+
+	// Products and domains share indexes, a domain is registered alongside
+	// the product with the same domain name.
+	domainIndex := map[string]int{}
+	for i, p := range o.Products {
+		if p.PID < 1 {
+			return nil, fmt.Errorf("product %d has no product ID", i)
+		}
+		parms[fmt.Sprintf("pid[%d]", i)] = fmt.Sprintf("%d", p.PID)
+		if len(p.Domain) > 0 {
+			parms[fmt.Sprintf("domain[%d]", i)] = p.Domain
+			domainIndex[strings.ToLower(p.Domain)] = i
+		}
+		if len(p.BillingCycle) > 0 {
+			parms[fmt.Sprintf("billingcycle[%d]", i)] = p.BillingCycle
+		}
+		if len(p.Addons) > 0 {
+			parms[fmt.Sprintf("addons[%d]", i)] = joinInts(p.Addons)
+		}
+		if len(p.ConfigOptions) > 0 {
+			parms[fmt.Sprintf("configoptions[%d]", i)] = serializeConfigOptions(p.ConfigOptions)
+		}
+		if len(p.CustomFields) > 0 {
+			parms[fmt.Sprintf("customfields[%d]", i)] = serializeCustomFields(p.CustomFields)
+		}
+		if p.PriceOverride != nil {
+			parms[fmt.Sprintf("priceoverride[%d]", i)] = fmt.Sprintf("%.2f", *p.PriceOverride)
+		}
+	}
+
+	next := len(o.Products)
+	for _, d := range o.Domains {
+		switch d.DomainType {
+		case "register", "transfer":
+		default:
+			return nil, fmt.Errorf("unsupported domain type for %s: %s", d.Domain, d.DomainType)
+		}
+
+		i, ok := domainIndex[strings.ToLower(d.Domain)]
+		if !ok {
+			i = next
+			next++
+			parms[fmt.Sprintf("domain[%d]", i)] = d.Domain
+		}
+
+		parms[fmt.Sprintf("domaintype[%d]", i)] = d.DomainType
+		if d.RegPeriod > 0 {
+			parms[fmt.Sprintf("regperiod[%d]", i)] = fmt.Sprintf("%d", d.RegPeriod)
+		}
+		if len(d.EppCode) > 0 {
+			parms[fmt.Sprintf("eppcode[%d]", i)] = d.EppCode
+		}
+		if d.IDProtection {
+			parms[fmt.Sprintf("idprotection[%d]", i)] = FormatBool(d.IDProtection)
+		}
+		if d.DNSManagement {
+			parms[fmt.Sprintf("dnsmanagement[%d]", i)] = FormatBool(d.DNSManagement)
+		}
+		if d.EmailForwarding {
+			parms[fmt.Sprintf("emailforwarding[%d]", i)] = FormatBool(d.EmailForwarding)
+		}
+	}
+
+	for i, a := range o.Addons {
+		if a.AddonID < 1 || a.ServiceID < 1 {
+			return nil, fmt.Errorf("addon %d requires an addon ID and service ID", i)
+		}
+		parms[fmt.Sprintf("addonids[%d]", i)] = fmt.Sprintf("%d", a.AddonID)
+		parms[fmt.Sprintf("serviceids[%d]", i)] = fmt.Sprintf("%d", a.ServiceID)
+	}
+
+	return parms, nil
+}
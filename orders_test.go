@@ -0,0 +1,58 @@
+package whmcsgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAddOrderRequest_toParams(t *testing.T) {
+	order := AddOrderRequest{
+		ClientID:      7,
+		PaymentMethod: "paypal",
+		PromoCode:     "SAVE10",
+		Nameservers:   []string{"ns1.example.com", "ns2.example.com"},
+		Products: []OrderProduct{
+			{PID: 1, Domain: "example.com", BillingCycle: "monthly", Addons: []int{4, 5}},
+			{PID: 2, BillingCycle: "annually", PriceOverride: Float64(9.5)},
+		},
+		Domains: []OrderDomain{
+			{Domain: "Example.com", DomainType: "register", RegPeriod: 2},
+			{Domain: "other.net", DomainType: "transfer", EppCode: "abc"},
+		},
+		Addons: []OrderAddon{{AddonID: 3, ServiceID: 99}},
+	}
+
+	got, err := order.toParams()
+	if err != nil {
+		t.Fatalf("AddOrderRequest.toParams() error = %v", err)
+	}
+
+	want := map[string]string{
+		"clientid":         "7",
+		"paymentmethod":    "paypal",
+		"promocode":        "SAVE10",
+		"nameserver1":      "ns1.example.com",
+		"nameserver2":      "ns2.example.com",
+		"pid[0]":           "1",
+		"domain[0]":        "example.com",
+		"billingcycle[0]":  "monthly",
+		"addons[0]":        "4,5",
+		"domaintype[0]":    "register",
+		"regperiod[0]":     "2",
+		"pid[1]":           "2",
+		"billingcycle[1]":  "annually",
+		"priceoverride[1]": "9.50",
+		"domain[2]":        "other.net",
+		"domaintype[2]":    "transfer",
+		"eppcode[2]":       "abc",
+		"addonids[0]":      "3",
+		"serviceids[0]":    "99",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AddOrderRequest.toParams() got = %v, want %v", got, want)
+	}
+
+	if _, err := (AddOrderRequest{ClientID: 7, PaymentMethod: "paypal"}).toParams(); err == nil {
+		t.Error("AddOrderRequest.toParams() expected an error for an empty order")
+	}
+}
@@ -0,0 +1,581 @@
+package whmcsgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Transaction a payment or refund recorded in WHMCS
+type Transaction struct {
+	ID          int       `json:"id"`
+	UserID      int       `json:"userid"`
+	Currency    int       `json:"currency"`
+	Gateway     string    `json:"gateway"`
+	Date        WHCMSdate `json:"date"`
+	Description string    `json:"description"`
+	AmountIn    string    `json:"amountin"`
+	Fees        string    `json:"fees"`
+	AmountOut   string    `json:"amountout"`
+	Rate        string    `json:"rate"`
+	TransID     string    `json:"transid"` // The payment gateway transaction ID
+	InvoiceID   int       `json:"invoiceid"`
+	RefundID    int       `json:"refundid"`
+}
+
+func (t Transaction) String() string {
+	return Stringify(t)
+}
+
+// Transactions a list of transactions, WHMCS returns an empty string rather
+// than an empty list when there are none
+type Transactions struct {
+	Transaction []Transaction `json:"transaction"`
+}
+
+// UnmarshalJSON interface, we need a function UnmarshalJSON on the Transactions type.
+func (t *Transactions) UnmarshalJSON(input []byte) error {
+	if isEmptyJSON(input) {
+		t.Transaction = nil
+		return nil
+	}
+
+	type transactions Transactions
+	return json.Unmarshal(input, (*transactions)(t))
+}
+
+// isEmptyJSON reports whether input is null, an empty string or an empty list
+func isEmptyJSON(input []byte) bool {
+	switch strings.TrimSpace(string(input)) {
+	case "null", `""`, "[]":
+		return true
+	}
+	return false
+}
+
+// TransactionsReply object from WHMCS
+type TransactionsReply struct {
+	Result       string       `json:"result"`
+	Totalresults int          `json:"totalresults"`
+	Startnumber  int          `json:"startnumber"`
+	Numreturned  int          `json:"numreturned"`
+	Transactions Transactions `json:"transactions"`
+}
+
+// InvoiceItem a line item on an existing invoice
+type InvoiceItem struct {
+	ID          int       `json:"id"` // The line item ID used by UpdateInvoice
+	Type        string    `json:"type"`
+	RelID       int       `json:"relid"`
+	Description string    `json:"description"`
+	Amount      string    `json:"amount"`
+	Taxed       WHMCSbool `json:"taxed"`
+}
+
+// InvoiceItems a list of invoice items, WHMCS returns an empty string rather
+// than an empty list when there are none
+type InvoiceItems struct {
+	Item []InvoiceItem `json:"item"`
+}
+
+// UnmarshalJSON interface, we need a function UnmarshalJSON on the InvoiceItems type.
+func (i *InvoiceItems) UnmarshalJSON(input []byte) error {
+	if isEmptyJSON(input) {
+		i.Item = nil
+		return nil
+	}
+
+	type invoiceItems InvoiceItems
+	return json.Unmarshal(input, (*invoiceItems)(i))
+}
+
+// InvoiceDetails an invoice with its line items and transactions
+type InvoiceDetails struct {
+	Result             string        `json:"result"`
+	InvoiceID          int           `json:"invoiceid"`
+	InvoiceNum         string        `json:"invoicenum"`
+	UserID             int           `json:"userid"`
+	Date               WHCMSdate     `json:"date"`
+	DueDate            WHCMSdate     `json:"duedate"`
+	DatePaid           WHCMSdate     `json:"datepaid"`
+	LastCaptureAttempt WHCMSdate     `json:"lastcaptureattempt"`
+	Subtotal           string        `json:"subtotal"`
+	Credit             string        `json:"credit"`
+	Tax                string        `json:"tax"`
+	Tax2               string        `json:"tax2"`
+	Total              string        `json:"total"`
+	Balance            string        `json:"balance"`
+	TaxRate            string        `json:"taxrate"`
+	TaxRate2           string        `json:"taxrate2"`
+	Status             InvoiceStatus `json:"status"`
+	PaymentMethod      string        `json:"paymentmethod"`
+	Notes              string        `json:"notes"`
+	CCGateway          WHMCSbool     `json:"ccgateway"`
+	Items              InvoiceItems  `json:"items"`
+	Transactions       Transactions  `json:"transactions"`
+}
+
+func (i InvoiceDetails) String() string {
+	return Stringify(i)
+}
+
+/*
+GetInvoice Retrieve a specific invoice with its line items and transactions
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getinvoice/
+
+Request Parameters
+
+invoiceid
+	int	The ID of the invoice to retrieve	Required
+*/
+func (s *BillingService) GetInvoice(invoiceID int) (*InvoiceDetails, *Response, error) {
+	if invoiceID < 1 {
+		return nil, nil, errors.New("invoice ID required to get an invoice")
+	}
+
+	r := new(InvoiceDetails)
+	parms := map[string]string{"invoiceid": fmt.Sprintf("%d", invoiceID)}
+
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetInvoice"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// InvoicePayment a payment to record against an invoice
+type InvoicePayment struct {
+	TransID string    // The unique transaction ID from the payment gateway, required
+	Gateway string    // The payment method in system format, required
+	Date    time.Time // The date the payment was made, defaults to now
+	Amount  float64   // The amount paid, defaults to the invoice balance
+	Fees    float64   // The fees charged by the payment gateway
+	NoEmail bool      // Do not send the invoice payment confirmation email
+}
+
+/*
+AddInvoicePayment Adds a payment to an invoice
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/addinvoicepayment/
+
+Request Parameters
+
+invoiceid
+	int	The invoice to apply the payment to	Required
+transid
+	string	The unique transaction ID that should be applied to the payment	Required
+gateway
+	string	The gateway used in system name format, eg. paypal, authorize	Required
+date
+	\Carbon\Carbon	The date the payment was made Y-m-d H:i:s	Optional
+amount
+	float	The amount paid, can be left undefined to take full amount of invoice	Optional
+fees
+	float	The amount of the payment that was taken as a fee by the gateway	Optional
+noemail
+	bool	Set to true to not send an invoice payment confirmation email	Optional
+*/
+func (s *BillingService) AddInvoicePayment(invoiceID int, payment InvoicePayment) (*ActionReply, *Response, error) {
+	if invoiceID < 1 || len(payment.TransID) == 0 || len(payment.Gateway) == 0 {
+		return nil, nil, errors.New("invoice ID, transaction ID and gateway required to add an invoice payment")
+	}
+
+	parms := map[string]string{
+		"invoiceid": fmt.Sprintf("%d", invoiceID),
+		"transid":   payment.TransID,
+		"gateway":   payment.Gateway,
+	}
+	if !payment.Date.IsZero() {
+		parms["date"] = payment.Date.Format("2006-01-02 15:04:05")
+	}
+	if payment.Amount > 0 {
+		parms["amount"] = fmt.Sprintf("%.2f", payment.Amount)
+	}
+	if payment.Fees > 0 {
+		parms["fees"] = fmt.Sprintf("%.2f", payment.Fees)
+	}
+	if payment.NoEmail {
+		parms["noemail"] = FormatBool(payment.NoEmail)
+	}
+
+	return s.billingAction("AddInvoicePayment", parms)
+}
+
+// TransactionRequest the transaction to be added or updated
+type TransactionRequest struct {
+	PaymentMethod string    // The payment method in system format, required when adding
+	UserID        int       // The client the transaction is for
+	InvoiceID     int       // The invoice the transaction is for
+	TransID       string    // The unique transaction ID from the payment gateway
+	Date          time.Time // The date of the transaction
+	Description   string    // The description of the transaction
+	AmountIn      float64   // The amount received
+	Fees          float64   // The fees charged by the payment gateway
+	AmountOut     float64   // The amount paid out
+	Rate          float64   // The exchange rate of the transaction
+	Credit        bool      // Add the amount to the client credit balance, only used when adding
+}
+
+func (t TransactionRequest) toParams() map[string]string {
+	parms := map[string]string{}
+
+	if len(t.PaymentMethod) > 0 {
+		parms["paymentmethod"] = t.PaymentMethod
+	}
+	if t.UserID > 0 {
+		parms["userid"] = fmt.Sprintf("%d", t.UserID)
+	}
+	if t.InvoiceID > 0 {
+		parms["invoiceid"] = fmt.Sprintf("%d", t.InvoiceID)
+	}
+	if len(t.TransID) > 0 {
+		parms["transid"] = t.TransID
+	}
+	if !t.Date.IsZero() {
+		parms["date"] = t.Date.Format("2006-01-02")
+	}
+	if len(t.Description) > 0 {
+		parms["description"] = t.Description
+	}
+	if t.AmountIn != 0 {
+		parms["amountin"] = fmt.Sprintf("%.2f", t.AmountIn)
+	}
+	if t.Fees != 0 {
+		parms["fees"] = fmt.Sprintf("%.2f", t.Fees)
+	}
+	if t.AmountOut != 0 {
+		parms["amountout"] = fmt.Sprintf("%.2f", t.AmountOut)
+	}
+	if t.Rate != 0 {
+		parms["rate"] = fmt.Sprintf("%g", t.Rate)
+	}
+
+	return parms
+}
+
+/*
+AddTransaction Add a transaction to the system
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/addtransaction/
+
+Request Parameters
+
+paymentmethod
+	string	The payment method of the transaction in system format	Required
+userid
+	int	The client the transaction is for	Optional
+invoiceid
+	int	The invoice the transaction is for	Optional
+transid
+	string	The unique transaction ID	Optional
+date
+	\Carbon\Carbon	The date of the transaction	Optional
+description
+	string	The description of the transaction	Optional
+amountin
+	float	The amount received	Optional
+fees
+	float	The fees charged by the payment gateway	Optional
+amountout
+	float	The amount paid out	Optional
+rate
+	float	The exchange rate of the transaction	Optional
+credit
+	bool	Should the transaction be added to the client credit balance	Optional
+*/
+func (s *BillingService) AddTransaction(transaction TransactionRequest) (*ActionReply, *Response, error) {
+	if len(transaction.PaymentMethod) == 0 {
+		return nil, nil, errors.New("payment method required to add a transaction")
+	}
+
+	parms := transaction.toParams()
+	if transaction.Credit {
+		parms["credit"] = FormatBool(transaction.Credit)
+	}
+
+	return s.billingAction("AddTransaction", parms)
+}
+
+/*
+UpdateTransaction Updates a transaction in the system
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/updatetransaction/
+
+Request Parameters
+
+transactionid
+	int	The ID of the transaction to update	Required
+
+Other parameters are the same as AddTransaction and only set fields are sent.
+*/
+func (s *BillingService) UpdateTransaction(transactionID int, transaction TransactionRequest) (*ActionReply, *Response, error) {
+	if transactionID < 1 {
+		return nil, nil, errors.New("transaction ID required to update a transaction")
+	}
+
+	parms := transaction.toParams()
+	parms["transactionid"] = fmt.Sprintf("%d", transactionID)
+	if gateway, ok := parms["paymentmethod"]; ok {
+		delete(parms, "paymentmethod")
+		parms["gateway"] = gateway
+	}
+
+	return s.billingAction("UpdateTransaction", parms)
+}
+
+/*
+GetTransactions Obtain transactions matching the passed criteria
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/gettransactions/
+
+Request Parameters
+
+invoiceid
+	int	Obtain transactions for a specific invoice id	Optional
+clientid
+	int	Find transactions for a specific client id	Optional
+transid
+	string	Find transactions for a specific transaction id	Optional
+*/
+func (s *BillingService) GetTransactions(parms map[string]string) (*TransactionsReply, *Response, error) {
+	r := new(TransactionsReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetTransactions"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// Credit a credit added to or removed from a client account
+type Credit struct {
+	ID          int       `json:"id"`
+	Date        WHCMSdate `json:"date"`
+	Description string    `json:"description"`
+	Amount      string    `json:"amount"`
+	RelID       int       `json:"relid"`
+}
+
+// CreditsReply object from WHMCS
+type CreditsReply struct {
+	Result       string      `json:"result"`
+	Totalresults int         `json:"totalresults"`
+	ClientID     json.Number `json:"clientid"`
+	Credits      struct {
+		Credit []Credit `json:"credit"`
+	} `json:"credits"`
+}
+
+// AddCreditReply the client credit balance after adding a credit
+type AddCreditReply struct {
+	Result     string `json:"result"`
+	NewBalance string `json:"newbalance"`
+}
+
+/*
+AddCredit Adds credit to a given client, pass a negative amount to remove credit
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/addcredit/
+
+Request Parameters
+
+clientid
+	int	The client ID to add credit to	Required
+description
+	string	The description of the credit	Required
+amount
+	float	The amount to add or remove	Required
+date
+	\Carbon\Carbon	The date the credit was added Y-m-d	Optional
+adminid
+	int	The admin to associate the credit with	Optional
+type
+	string	Whether to add or remove credit: add or remove	Optional
+*/
+func (s *BillingService) AddCredit(clientID int, description string, amount float64, date time.Time) (*AddCreditReply, *Response, error) {
+	if clientID < 1 || len(description) == 0 || amount == 0 {
+		return nil, nil, errors.New("client ID, description and amount required to add credit")
+	}
+
+	parms := map[string]string{
+		"clientid":    fmt.Sprintf("%d", clientID),
+		"description": description,
+		"amount":      fmt.Sprintf("%.2f", amount),
+		"type":        "add",
+	}
+	if amount < 0 {
+		parms["amount"] = fmt.Sprintf("%.2f", -amount)
+		parms["type"] = "remove"
+	}
+	if !date.IsZero() {
+		parms["date"] = date.Format("2006-01-02")
+	}
+
+	r := new(AddCreditReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "AddCredit"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// ApplyCreditReply the result of applying credit to an invoice
+type ApplyCreditReply struct {
+	Result      string      `json:"result"`
+	InvoiceID   json.Number `json:"invoiceid"`
+	Amount      string      `json:"amount"`
+	InvoicePaid WHMCSbool   `json:"invoicepaid"`
+}
+
+/*
+ApplyCredit Applies the client's credit to an invoice
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/applycredit/
+
+Request Parameters
+
+invoiceid
+	int	The invoice to apply credit to	Required
+amount
+	float	The amount of credit to apply, pass zero for the full balance	Required
+noemail
+	bool	Do not send the invoice payment confirmation email	Optional
+*/
+func (s *BillingService) ApplyCredit(invoiceID int, amount float64, noEmail bool) (*ApplyCreditReply, *Response, error) {
+	if invoiceID < 1 {
+		return nil, nil, errors.New("invoice ID required to apply credit")
+	}
+
+	parms := map[string]string{
+		"invoiceid": fmt.Sprintf("%d", invoiceID),
+		"amount":    "full",
+		"noemail":   FormatBool(noEmail),
+	}
+	if amount > 0 {
+		parms["amount"] = fmt.Sprintf("%.2f", amount)
+	}
+
+	r := new(ApplyCreditReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "ApplyCredit"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+/*
+GetCredits Obtain the credit log for a client
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getcredits/
+
+Request Parameters
+
+clientid
+	int	The client ID to obtain the credit log for	Required
+*/
+func (s *BillingService) GetCredits(clientID int) (*CreditsReply, *Response, error) {
+	if clientID < 1 {
+		return nil, nil, errors.New("client ID required to get credits")
+	}
+
+	r := new(CreditsReply)
+	parms := map[string]string{"clientid": fmt.Sprintf("%d", clientID)}
+
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetCredits"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// GenInvoicesReply the invoices generated by GenInvoices
+type GenInvoicesReply struct {
+	Result          string      `json:"result"`
+	NumCreated      int         `json:"numcreated"`
+	LatestInvoiceID json.Number `json:"latestinvoiceid"`
+}
+
+/*
+GenInvoices Generate any invoices that are due to be generated
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/geninvoices/
+
+Request Parameters
+
+noemails
+	bool	Do not send the Invoice Created emails	Optional
+clientid
+	int	Only generate invoices for a specific client	Optional
+serviceids
+	int[]	Only generate invoices for specific services	Optional
+domainids
+	int[]	Only generate invoices for specific domains	Optional
+addonids
+	int[]	Only generate invoices for specific addons	Optional
+*/
+func (s *BillingService) GenInvoices(parms map[string]string) (*GenInvoicesReply, *Response, error) {
+	r := new(GenInvoicesReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GenInvoices"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// billingAction sends a billing action that only reports success
+func (s *BillingService) billingAction(action string, parms map[string]string) (*ActionReply, *Response, error) {
+	r := new(ActionReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: action}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
@@ -0,0 +1,59 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestBillingService_GetInvoice(t *testing.T) {
+	tests := []struct {
+		name             string
+		body             string
+		wantItems        int
+		wantTransactions int
+	}{
+		{
+			name: "No transactions",
+			body: `{"result":"success","invoiceid":12,"invoicenum":"","userid":3,"date":"2021-03-01","duedate":"2021-03-15",` +
+				`"datepaid":"0000-00-00 00:00:00","subtotal":"10.00","credit":"0.00","tax":"1.00","tax2":"0.00","total":"11.00",` +
+				`"balance":"11.00","status":"Unpaid","paymentmethod":"banktransfer","ccgateway":false,` +
+				`"items":{"item":[{"id":40,"type":"","relid":0,"description":"Consulting","amount":"10.00","taxed":1}]},"transactions":""}`,
+			wantItems: 1,
+		},
+		{
+			name: "Paid",
+			body: `{"result":"success","invoiceid":12,"userid":3,"date":"2021-03-01","duedate":"2021-03-15",` +
+				`"datepaid":"2021-03-02 10:11:12","total":"11.00","balance":"0.00","status":"Paid",` +
+				`"items":{"item":[{"id":40,"description":"Consulting","amount":"10.00","taxed":1}]},` +
+				`"transactions":{"transaction":[{"id":5,"userid":3,"gateway":"paypal","date":"2021-03-02 10:11:12","amountin":"11.00","transid":"ABC","invoiceid":12}]}}`,
+			wantItems:        1,
+			wantTransactions: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tclient := NewTestClient(func(req *http.Request) *http.Response {
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(tt.body)),
+					Header:     make(http.Header),
+				}
+			})
+
+			s := &BillingService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+			got, _, err := s.GetInvoice(12)
+			if err != nil {
+				t.Fatalf("BillingService.GetInvoice() error = %v", err)
+			}
+			if len(got.Items.Item) != tt.wantItems || len(got.Transactions.Transaction) != tt.wantTransactions {
+				t.Errorf("BillingService.GetInvoice() got = %v", got)
+			}
+			if !got.Items.Item[0].Taxed {
+				t.Errorf("BillingService.GetInvoice() item not taxed")
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package whmcsgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Pay method types accepted by AddPayMethod and GetPayMethods
+const (
+	PayMethodCreditCard        = "CreditCard"
+	PayMethodBankAccount       = "BankAccount"
+	PayMethodRemoteCreditCard  = "RemoteCreditCard"
+	PayMethodRemoteBankAccount = "RemoteBankAccount"
+)
+
+// PayMethod a card or bank account stored against a client (WHMCS 7.9+)
+type PayMethod struct {
+	ID              int       `json:"id"`
+	Type            string    `json:"type"`
+	Description     string    `json:"description"`
+	GatewayName     string    `json:"gateway_name"`
+	ContactType     string    `json:"contact_type"`
+	ContactID       int       `json:"contact_id"`
+	CardLastFour    string    `json:"card_last_four"`
+	ExpiryDate      string    `json:"expiry_date"` // MM/YY
+	StartDate       string    `json:"start_date"`
+	IssueNumber     string    `json:"issue_number"`
+	CardType        string    `json:"card_type"`
+	RemoteToken     string    `json:"remote_token"` // The token stored by the payment gateway
+	BankName        string    `json:"bank_name"`
+	LastUpdated     string    `json:"last_updated"`
+	IsDefault       WHMCSbool `json:"is_default"`
+	IsPaymentMethod WHMCSbool `json:"is_payment_method"`
+}
+
+func (p PayMethod) String() string {
+	return Stringify(p)
+}
+
+// Expiry returns the last moment the card can be used, ok is false for bank
+// accounts and cards without a valid expiry date
+func (p PayMethod) Expiry() (expiry time.Time, ok bool) {
+	parts := strings.Split(p.ExpiryDate, "/")
+	if len(parts) != 2 {
+		return time.Time{}, false
+	}
+
+	month, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil || month < 1 || month > 12 {
+		return time.Time{}, false
+	}
+
+	year, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return time.Time{}, false
+	}
+	if year < 100 {
+		year += 2000
+	}
+
+	// The first moment of the following month, less a second
+	return time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second), true
+}
+
+// ExpiresWithin reports whether the card expires before now plus d, expired
+// cards are included
+func (p PayMethod) ExpiresWithin(d time.Duration, now time.Time) bool {
+	expiry, ok := p.Expiry()
+	if !ok {
+		return false
+	}
+	return expiry.Before(now.Add(d))
+}
+
+// PayMethodsReply object from WHMCS
+type PayMethodsReply struct {
+	Result     string      `json:"result"`
+	ClientID   json.Number `json:"clientid"`
+	PayMethods []PayMethod `json:"paymethods"`
+}
+
+// PayMethodReply the status after adding, updating or deleting a pay method
+type PayMethodReply struct {
+	Result      string      `json:"result"`
+	ClientID    json.Number `json:"clientid"`
+	PayMethodID int         `json:"paymethodid"`
+}
+
+/*
+GetPayMethods Obtain the Pay Methods for a client
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getpaymethods/
+
+Request Parameters
+
+clientid
+	int	The id of the client to obtain the Pay Methods for	Required
+paymethodid
+	int	The id of a specific Pay Method	Optional
+type
+	string	The type of Pay Method to return: BankAccount or CreditCard	Optional
+*/
+func (s *BillingService) GetPayMethods(clientID int, payMethodType string) (*PayMethodsReply, *Response, error) {
+	if clientID < 1 {
+		return nil, nil, errors.New("client ID required to get pay methods")
+	}
+
+	parms := map[string]string{"clientid": fmt.Sprintf("%d", clientID)}
+	if len(payMethodType) > 0 {
+		parms["type"] = payMethodType
+	}
+
+	r := new(PayMethodsReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetPayMethods"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// PayMethodRequest the card or bank account to store or update
+type PayMethodRequest struct {
+	Type              string // CreditCard, BankAccount, RemoteCreditCard or RemoteBankAccount, only used when adding
+	Description       string // The description of the pay method
+	GatewayModuleName string // The gateway module for remote pay methods, only used when adding
+	CardNumber        string // The card number
+	CardExpiry        string // The card expiry date MMYY
+	CardStart         string // The card start date MMYY
+	CardIssueNumber   string // The card issue number
+	BankName          string // The name of the bank
+	BankAccountType   string // The type of bank account: Checking or Savings
+	BankCode          string // The bank routing or sort code
+	BankAccount       string // The bank account number
+	SetAsDefault      bool   // Make this the default pay method of the client
+}
+
+func (p PayMethodRequest) toParams() map[string]string {
+	parms := map[string]string{}
+	set := func(k, v string) {
+		if len(v) > 0 {
+			parms[k] = v
+		}
+	}
+
+	set("description", p.Description)
+	set("card_number", p.CardNumber)
+	set("card_expiry", p.CardExpiry)
+	set("card_start", p.CardStart)
+	set("card_issue_number", p.CardIssueNumber)
+	set("bank_name", p.BankName)
+	set("bank_account_type", p.BankAccountType)
+	set("bank_code", p.BankCode)
+	set("bank_account", p.BankAccount)
+	if p.SetAsDefault {
+		parms["set_as_default"] = FormatBool(p.SetAsDefault)
+	}
+
+	return parms
+}
+
+/*
+AddPayMethod Add a Pay Method to a client
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/addpaymethod/
+
+Request Parameters
+
+clientid
+	int	The id of the client to add the Pay Method to	Required
+type
+	string	The type of Pay Method: CreditCard, BankAccount, RemoteCreditCard or RemoteBankAccount	Required
+description
+	string	The description of the Pay Method	Optional
+gateway_module_name
+	string	The gateway module for remote Pay Methods	Optional
+
+Card and bank fields as per PayMethodRequest, see WHMCS API docs
+*/
+func (s *BillingService) AddPayMethod(clientID int, payMethod PayMethodRequest) (*PayMethodReply, *Response, error) {
+	if clientID < 1 {
+		return nil, nil, errors.New("client ID required to add a pay method")
+	}
+
+	switch payMethod.Type {
+	case PayMethodCreditCard, PayMethodBankAccount, PayMethodRemoteCreditCard, PayMethodRemoteBankAccount:
+	default:
+		return nil, nil, fmt.Errorf("unsupported pay method type: %s", payMethod.Type)
+	}
+
+	parms := payMethod.toParams()
+	parms["clientid"] = fmt.Sprintf("%d", clientID)
+	parms["type"] = payMethod.Type
+	if len(payMethod.GatewayModuleName) > 0 {
+		parms["gateway_module_name"] = payMethod.GatewayModuleName
+	}
+
+	return s.payMethodAction("AddPayMethod", parms)
+}
+
+/*
+UpdatePayMethod Update a Pay Method of a client
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/updatepaymethod/
+
+Request Parameters
+
+clientid
+	int	The id of the client the Pay Method belongs to	Required
+paymethodid
+	int	The id of the Pay Method to update	Required
+
+Card and bank fields as per PayMethodRequest, only set fields are sent
+*/
+func (s *BillingService) UpdatePayMethod(clientID, payMethodID int, payMethod PayMethodRequest) (*PayMethodReply, *Response, error) {
+	if clientID < 1 || payMethodID < 1 {
+		return nil, nil, errors.New("client ID and pay method ID required to update a pay method")
+	}
+
+	parms := payMethod.toParams()
+	parms["clientid"] = fmt.Sprintf("%d", clientID)
+	parms["paymethodid"] = fmt.Sprintf("%d", payMethodID)
+
+	return s.payMethodAction("UpdatePayMethod", parms)
+}
+
+/*
+DeletePayMethod Delete a Pay Method of a client
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/deletepaymethod/
+
+Request Parameters
+
+clientid
+	int	The id of the client the Pay Method belongs to	Required
+paymethodid
+	int	The id of the Pay Method to delete	Required
+failonremotefailure
+	bool	Fail when the remote gateway can not delete the stored token	Optional
+*/
+func (s *BillingService) DeletePayMethod(clientID, payMethodID int, failOnRemoteFailure bool) (*PayMethodReply, *Response, error) {
+	if clientID < 1 || payMethodID < 1 {
+		return nil, nil, errors.New("client ID and pay method ID required to delete a pay method")
+	}
+
+	parms := map[string]string{
+		"clientid":            fmt.Sprintf("%d", clientID),
+		"paymethodid":         fmt.Sprintf("%d", payMethodID),
+		"failonremotefailure": FormatBool(failOnRemoteFailure),
+	}
+
+	return s.payMethodAction("DeletePayMethod", parms)
+}
+
+// payMethodAction sends a pay method action and decodes the reply
+func (s *BillingService) payMethodAction(action string, parms map[string]string) (*PayMethodReply, *Response, error) {
+	r := new(PayMethodReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: action}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// ExpiringPayMethods returns the cards of a client that expire within d, including expired cards
+func (s *BillingService) ExpiringPayMethods(clientID int, d time.Duration) ([]PayMethod, error) {
+	r, _, err := s.GetPayMethods(clientID, PayMethodCreditCard)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiring []PayMethod
+	now := time.Now()
+	for _, p := range r.PayMethods {
+		if p.ExpiresWithin(d, now) {
+			expiring = append(expiring, p)
+		}
+	}
+	return expiring, nil
+}
+
+// ErrCaptureWithPayMethod returned by CapturePaymentWithPayMethod, WHMCS has no way to choose the pay method of a capture
+var ErrCaptureWithPayMethod = errors.New("capturing a payment with a chosen pay method is not supported by the WHMCS API")
+
+/*
+CapturePaymentWithPayMethod is not supported by WHMCS and always returns
+ErrCaptureWithPayMethod.
+
+CapturePayment only takes the invoice and a CVV and charges the pay method
+WHMCS has for the invoice, and neither CapturePayment nor UpdateInvoice
+accept a pay method.
+*/
+func (s *BillingService) CapturePaymentWithPayMethod(clientID, invoiceID, payMethodID int) (*CaptureResult, *Response, error) {
+	return nil, nil, ErrCaptureWithPayMethod
+}
@@ -0,0 +1,80 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPayMethod_ExpiresWithin(t *testing.T) {
+	now := time.Date(2021, 3, 15, 0, 0, 0, 0, time.UTC)
+	month := 31 * 24 * time.Hour
+	tests := []struct {
+		name   string
+		expiry string
+		want   bool
+	}{
+		{name: "Expired", expiry: "02/21", want: true},
+		{name: "This month", expiry: "03/21", want: true},
+		{name: "Next month", expiry: "04/21", want: false},
+		{name: "Bank account", expiry: "", want: false},
+		{name: "Invalid", expiry: "13/21", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := PayMethod{ExpiryDate: tt.expiry}
+			if got := p.ExpiresWithin(month, now); got != tt.want {
+				t.Errorf("PayMethod.ExpiresWithin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBillingService_CapturePaymentWithPayMethod(t *testing.T) {
+	var actions []string
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		actions = append(actions, req.PostForm.Get("action"))
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"result":"success"}`)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &BillingService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	if _, _, err := s.CapturePaymentWithPayMethod(3, 31, 6); err != ErrCaptureWithPayMethod {
+		t.Errorf("BillingService.CapturePaymentWithPayMethod() error = %v, want %v", err, ErrCaptureWithPayMethod)
+	}
+	if len(actions) != 0 {
+		t.Errorf("BillingService.CapturePaymentWithPayMethod() actions = %v, want none", actions)
+	}
+}
+
+func TestInvoice_Paymethodid(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want WHMCSint
+	}{
+		{name: "Number", body: `{"id":1,"paymethodid":12}`, want: 12},
+		{name: "String", body: `{"id":1,"paymethodid":"12"}`, want: 12},
+		{name: "Empty", body: `{"id":1,"paymethodid":""}`, want: 0},
+		{name: "Null", body: `{"id":1,"paymethodid":null}`, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Invoice
+			if err := json.Unmarshal([]byte(tt.body), &got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if got.Paymethodid != tt.want {
+				t.Errorf("Invoice.Paymethodid = %d, want %d", got.Paymethodid, tt.want)
+			}
+		})
+	}
+}
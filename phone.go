@@ -0,0 +1,202 @@
+package whmcsgo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PhoneNumber a phone number split into the country calling code and the national significant number
+type PhoneNumber struct {
+	CountryCode int    // The country calling code, 61 for Australia
+	Number      string // The national number without the trunk prefix, digits only
+	trunk       bool   // Whether the number was written with the trunk prefix
+}
+
+// E164 returns the number in E.164 format, +61412345678
+func (p PhoneNumber) E164() string {
+	return fmt.Sprintf("+%d%s", p.CountryCode, p.Number)
+}
+
+// phoneRule how numbers are written in a country
+type phoneRule struct {
+	trunk  string                                             // The prefix dialled before national numbers
+	groups func(number string) (groups []int, withTrunk bool) // The digit groups of a national number without the trunk prefix, and whether it is dialled with it
+}
+
+// phoneRules the countries with known national formats, keyed by country calling code
+var phoneRules = map[int]phoneRule{
+	1: {groups: func(n string) ([]int, bool) { return []int{3, 3, 4}, false }},
+	44: {trunk: "0", groups: func(n string) ([]int, bool) {
+		if strings.HasPrefix(n, "7") {
+			return []int{4, 6}, true
+		}
+		return []int{3, 3, 4}, true
+	}},
+	61: {trunk: "0", groups: func(n string) ([]int, bool) {
+		switch {
+		case strings.HasPrefix(n, "13") && len(n) == 6:
+			return []int{2, 2, 2}, false
+		case strings.HasPrefix(n, "1"):
+			// 1300, 1800 and other non-geographic numbers are dialled without the trunk prefix
+			return []int{4, 3, 3}, false
+		case strings.HasPrefix(n, "4"):
+			return []int{3, 3, 3}, true
+		}
+		return []int{1, 4, 4}, true
+	}},
+	64: {trunk: "0", groups: func(n string) ([]int, bool) {
+		if strings.HasPrefix(n, "2") {
+			return []int{2, 3, 4}, true
+		}
+		return []int{1, 3, 4}, true
+	}},
+}
+
+/*
+ParsePhone parses a phone number as WHMCS stores it. phonenumberformatted is
+written +CC.NUMBER, numbers without a country code are read as national
+numbers of countryCode, usually the phonecc field of the client.
+*/
+func ParsePhone(number string, countryCode int) (PhoneNumber, error) {
+	number = strings.TrimSpace(number)
+	if number == "" {
+		return PhoneNumber{}, errors.New("no phone number")
+	}
+
+	international := strings.HasPrefix(number, "+") || strings.HasPrefix(number, "00")
+	if international {
+		number = strings.TrimPrefix(strings.TrimPrefix(number, "+"), "00")
+		if dot := strings.Index(number, "."); dot > 0 {
+			cc, err := strconv.Atoi(strings.TrimSpace(number[:dot]))
+			if err != nil {
+				return PhoneNumber{}, fmt.Errorf("invalid country code in %q", number)
+			}
+			countryCode = cc
+			number = number[dot+1:]
+			international = false
+		}
+	}
+
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, number)
+
+	if international {
+		// Prefer the country of the client, then the countries with known formats
+		if cc := strconv.Itoa(countryCode); countryCode < 1 || !strings.HasPrefix(digits, cc) {
+			countryCode = 0
+			for code := range phoneRules {
+				if strings.HasPrefix(digits, strconv.Itoa(code)) {
+					countryCode = code
+					break
+				}
+			}
+		}
+		if countryCode < 1 {
+			return PhoneNumber{}, fmt.Errorf("unknown country code in %q", number)
+		}
+		digits = strings.TrimPrefix(digits, strconv.Itoa(countryCode))
+	}
+
+	if countryCode < 1 {
+		return PhoneNumber{}, fmt.Errorf("no country code for %q", number)
+	}
+
+	trunk := false
+	if rule, ok := phoneRules[countryCode]; ok && rule.trunk != "" && strings.HasPrefix(digits, rule.trunk) {
+		digits = strings.TrimPrefix(digits, rule.trunk)
+		trunk = true
+	}
+
+	if digits == "" {
+		return PhoneNumber{}, errors.New("no phone number")
+	}
+	return PhoneNumber{CountryCode: countryCode, Number: digits, trunk: trunk}, nil
+}
+
+/*
+groupDigits splits number into the groups of its country, or returns it
+unchanged when the country or length is unknown. matched reports whether a
+grouping rule was used and withTrunk whether that rule dials the trunk prefix.
+*/
+func groupDigits(p PhoneNumber) (grouped string, matched, withTrunk bool) {
+	rule, ok := phoneRules[p.CountryCode]
+	if !ok {
+		return p.Number, false, false
+	}
+
+	groups, withTrunk := rule.groups(p.Number)
+	total := 0
+	for _, g := range groups {
+		total += g
+	}
+	if total != len(p.Number) {
+		return p.Number, false, false
+	}
+
+	parts := make([]string, 0, len(groups))
+	start := 0
+	for _, g := range groups {
+		parts = append(parts, p.Number[start:start+g])
+		start += g
+	}
+	return strings.Join(parts, " "), true, withTrunk
+}
+
+// PhoneFormatter formats a parsed phone number for display
+type PhoneFormatter interface {
+	FormatPhone(p PhoneNumber) string
+}
+
+// PhoneFormatterFunc adapts a function to a PhoneFormatter
+type PhoneFormatterFunc func(p PhoneNumber) string
+
+// FormatPhone calls f(p)
+func (f PhoneFormatterFunc) FormatPhone(p PhoneNumber) string {
+	return f(p)
+}
+
+// E164PhoneFormatter formats numbers as +61412345678
+var E164PhoneFormatter = PhoneFormatterFunc(PhoneNumber.E164)
+
+// InternationalPhoneFormatter formats numbers as +61 412 345 678
+var InternationalPhoneFormatter = PhoneFormatterFunc(func(p PhoneNumber) string {
+	grouped, _, _ := groupDigits(p)
+	return fmt.Sprintf("+%d %s", p.CountryCode, grouped)
+})
+
+/*
+HomePhoneFormatter formats numbers in the home country in national format,
+0412 345 678 for Australia, and all other numbers in international format.
+The trunk prefix is only added when the grouping rule of the number dials it,
+or when a number without a rule was written with it.
+*/
+type HomePhoneFormatter struct {
+	CountryCode int
+}
+
+// FormatPhone formats p nationally when it is in the home country
+func (h HomePhoneFormatter) FormatPhone(p PhoneNumber) string {
+	if p.CountryCode != h.CountryCode {
+		return InternationalPhoneFormatter(p)
+	}
+	grouped, matched, withTrunk := groupDigits(p)
+	if (matched && withTrunk) || (!matched && p.trunk) {
+		return phoneRules[p.CountryCode].trunk + grouped
+	}
+	return grouped
+}
+
+// FormatPhone parses number and formats it with f, numbers that can not be parsed are returned trimmed
+func FormatPhone(f PhoneFormatter, number string, countryCode int) string {
+	p, err := ParsePhone(number, countryCode)
+	if err != nil {
+		return strings.TrimSpace(number)
+	}
+	return f.FormatPhone(p)
+}
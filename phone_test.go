@@ -0,0 +1,40 @@
+package whmcsgo
+
+import "testing"
+
+func TestFormatPhone(t *testing.T) {
+	home := HomePhoneFormatter{CountryCode: 61}
+	tests := []struct {
+		name   string
+		f      PhoneFormatter
+		number string
+		cc     int
+		want   string
+	}{
+		{name: "AU mobile", f: home, number: "+61.412345678", cc: 61, want: "0412 345 678"},
+		{name: "AU landline", f: home, number: "+61.298765432", cc: 61, want: "02 9876 5432"},
+		{name: "AU national input", f: home, number: "0412 345 678", cc: 61, want: "0412 345 678"},
+		{name: "AU 1300", f: home, number: "1300 123 456", cc: 61, want: "1300 123 456"},
+		{name: "AU 1800", f: home, number: "+61.1800123456", cc: 61, want: "1800 123 456"},
+		{name: "AU 13", f: home, number: "+61.131234", cc: 61, want: "13 12 34"},
+		{name: "AU 1300 international", f: InternationalPhoneFormatter, number: "+61.1300123456", cc: 61, want: "+61 1300 123 456"},
+		{name: "AU unknown length", f: home, number: "+61.12345", cc: 61, want: "12345"},
+		{name: "AU unknown length with trunk", f: home, number: "02 9876 543", cc: 61, want: "029876543"},
+		{name: "UK mobile", f: home, number: "+44.7700900123", cc: 44, want: "+44 7700 900123"},
+		{name: "US", f: home, number: "+1.5551234567", cc: 1, want: "+1 555 123 4567"},
+		{name: "Unknown country", f: home, number: "+49.301234567", cc: 49, want: "+49 301234567"},
+		{name: "International without dot", f: home, number: "+44 7700 900123", cc: 61, want: "+44 7700 900123"},
+		{name: "E164", f: E164PhoneFormatter, number: "+61.412345678", cc: 61, want: "+61412345678"},
+		{name: "E164 national input", f: E164PhoneFormatter, number: "(02) 9876 5432", cc: 61, want: "+61298765432"},
+		{name: "International", f: InternationalPhoneFormatter, number: "+61.412345678", cc: 61, want: "+61 412 345 678"},
+		{name: "Empty", f: home, number: " ", cc: 61, want: ""},
+		{name: "No country", f: home, number: "12345", cc: 0, want: "12345"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatPhone(tt.f, tt.number, tt.cc); got != tt.want {
+				t.Errorf("FormatPhone() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,13 +1,212 @@
 package whmcsgo
 
-// ProductsService handles .... related
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ProductsService handles communication with the product catalogue related
 // methods of the WHMCS API.
 //
 // WHMCS API docs: https://developers.whmcs.com/api/api-index/
 type ProductsService struct {
 	client *Client
 }
+
+// Product from the WHMCS product catalogue
 type Product struct {
-	Result string `json:"result"`
-	Pid    int    `json:"pid"`
+	Result       string                    `json:"result"` // Only set on the reply of AddProduct
+	Pid          int                       `json:"pid"`
+	Gid          int                       `json:"gid"`
+	Type         string                    `json:"type"`
+	Name         string                    `json:"name"`
+	Description  string                    `json:"description"`
+	Module       string                    `json:"module"`
+	PayType      string                    `json:"paytype"` // free, onetime or recurring
+	StockControl WHMCSbool                 `json:"stockcontrol"`
+	Quantity     int                       `json:"quantity"` // The stock available when StockControl is enabled
+	Pricing      map[string]ProductPricing `json:"pricing"`  // Keyed by currency code
+	CustomFields struct {
+		CustomField []ProductCustomField `json:"customfield"`
+	} `json:"customfields"`
+	ConfigOptions struct {
+		ConfigOption []ProductConfigOption `json:"configoption"`
+	} `json:"configoptions"`
+}
+
+func (p Product) String() string {
+	return Stringify(p)
+}
+
+// InStock reports whether the product can be ordered
+func (p Product) InStock() bool {
+	return !bool(p.StockControl) || p.Quantity > 0
+}
+
+// ProductPricing the price of a product or option in one currency, a price
+// of -1.00 means the billing cycle is not offered
+type ProductPricing struct {
+	Prefix             string `json:"prefix"`
+	Suffix             string `json:"suffix"`
+	MonthlySetupFee    string `json:"msetupfee"`
+	QuarterlySetupFee  string `json:"qsetupfee"`
+	SemiAnnualSetupFee string `json:"ssetupfee"`
+	AnnualSetupFee     string `json:"asetupfee"`
+	BiennialSetupFee   string `json:"bsetupfee"`
+	TriennialSetupFee  string `json:"tsetupfee"`
+	Monthly            string `json:"monthly"`
+	Quarterly          string `json:"quarterly"`
+	SemiAnnually       string `json:"semiannually"`
+	Annually           string `json:"annually"`
+	Biennially         string `json:"biennially"`
+	Triennially        string `json:"triennially"`
+}
+
+/*
+Price returns the setup fee and recurring price for a billing cycle as named by
+WHMCS (Monthly, Quarterly, Semi-Annually, Annually, Biennially, Triennially or
+One Time). ok is false when the cycle is not offered.
+*/
+func (p ProductPricing) Price(billingCycle string) (setup, recurring float64, ok bool) {
+	var s, r string
+
+	switch strings.ToLower(strings.Replace(billingCycle, " ", "", -1)) {
+	case "monthly", "onetime", "one-time":
+		s, r = p.MonthlySetupFee, p.Monthly
+	case "quarterly":
+		s, r = p.QuarterlySetupFee, p.Quarterly
+	case "semi-annually", "semiannually":
+		s, r = p.SemiAnnualSetupFee, p.SemiAnnually
+	case "annually":
+		s, r = p.AnnualSetupFee, p.Annually
+	case "biennially":
+		s, r = p.BiennialSetupFee, p.Biennially
+	case "triennially":
+		s, r = p.TriennialSetupFee, p.Triennially
+	case "freeaccount", "free":
+		return 0, 0, true
+	default:
+		return 0, 0, false
+	}
+
+	recurring, err := strconv.ParseFloat(r, 64)
+	if err != nil || recurring < 0 {
+		return 0, 0, false
+	}
+
+	setup, err = strconv.ParseFloat(s, 64)
+	if err != nil || setup < 0 {
+		setup = 0
+	}
+
+	return setup, recurring, true
+}
+
+// Format formats an amount with the currency prefix and suffix
+func (p ProductPricing) Format(amount float64) string {
+	return fmt.Sprintf("%s%.2f%s", p.Prefix, amount, p.Suffix)
+}
+
+// ProductCustomField a custom field defined on a product
+type ProductCustomField struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Required    WHMCSbool `json:"required"`
+}
+
+// ProductConfigOption a configurable option available on a product
+type ProductConfigOption struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Options struct {
+		Option []ProductConfigOptionValue `json:"option"`
+	} `json:"options"`
+}
+
+// ProductConfigOptionValue a selectable value of a configurable option
+type ProductConfigOptionValue struct {
+	ID        int                       `json:"id"`
+	Name      string                    `json:"name"`
+	Recurring int                       `json:"recurring"`
+	Pricing   map[string]ProductPricing `json:"pricing"` // Keyed by currency code
+}
+
+// ProductsReply object from WHMCS
+type ProductsReply struct {
+	Result       string `json:"result"`
+	Totalresults int    `json:"totalresults"`
+	Products     struct {
+		Product []Product `json:"product"`
+	} `json:"products"`
+}
+
+/*
+GetProducts Retrieve configured products matching provided criteria
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getproducts/
+
+Request Parameters
+
+pid
+	string	Obtain a specific product id configuration. Can be a list of ids comma separated	Optional
+gid
+	int	Retrieve products in a specific group id	Optional
+module
+	string	Retrieve products utilising a specific module	Optional
+*/
+func (s *ProductsService) GetProducts(parms map[string]string) (*ProductsReply, *Response, error) {
+	r := new(ProductsReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetProducts"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// ProductGroup the products within a product group
+type ProductGroup struct {
+	ID       int
+	Products []Product
+}
+
+/*
+GetProductGroups returns the product groups built from GetProducts.
+
+The WHMCS API has no product group action, so groups are identified by their
+gid only and are returned in gid order.
+*/
+func (s *ProductsService) GetProductGroups() ([]ProductGroup, error) {
+	r, _, err := s.GetProducts(map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := map[int]*ProductGroup{}
+	var ids []int
+	for _, p := range r.Products.Product {
+		g, ok := groups[p.Gid]
+		if !ok {
+			g = &ProductGroup{ID: p.Gid}
+			groups[p.Gid] = g
+			ids = append(ids, p.Gid)
+		}
+		g.Products = append(g.Products, p)
+	}
+
+	sort.Ints(ids)
+	result := make([]ProductGroup, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, *groups[id])
+	}
+	return result, nil
 }
@@ -0,0 +1,43 @@
+package whmcsgo
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestProductPricing_Price(t *testing.T) {
+	var p Product
+	body := `{"pid":1,"gid":2,"name":"Starter","paytype":"recurring","stockcontrol":"on","quantity":0,` +
+		`"pricing":{"AUD":{"prefix":"$","suffix":" AUD","msetupfee":"5.00","qsetupfee":"0.00","ssetupfee":"0.00",` +
+		`"asetupfee":"-1.00","bsetupfee":"0.00","tsetupfee":"0.00","monthly":"10.00","quarterly":"-1.00",` +
+		`"semiannually":"-1.00","annually":"100.00","biennially":"-1.00","triennially":"-1.00"}}}`
+	if err := json.Unmarshal([]byte(body), &p); err != nil {
+		t.Fatalf("json.Unmarshal failed: %v", err)
+	}
+
+	if p.InStock() {
+		t.Error("Product.InStock() got true for a stock controlled product with no quantity")
+	}
+
+	tests := []struct {
+		cycle     string
+		setup     float64
+		recurring float64
+		ok        bool
+	}{
+		{"Monthly", 5, 10, true},
+		{"Annually", 0, 100, true},
+		{"Semi-Annually", 0, 0, false},
+		{"Weekly", 0, 0, false},
+	}
+	for _, tt := range tests {
+		setup, recurring, ok := p.Pricing["AUD"].Price(tt.cycle)
+		if setup != tt.setup || recurring != tt.recurring || ok != tt.ok {
+			t.Errorf("ProductPricing.Price(%q) got = %v %v %v, want %v %v %v", tt.cycle, setup, recurring, ok, tt.setup, tt.recurring, tt.ok)
+		}
+	}
+
+	if got := p.Pricing["AUD"].Format(10); got != "$10.00 AUD" {
+		t.Errorf("ProductPricing.Format() got = %q", got)
+	}
+}
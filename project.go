@@ -1,9 +1,579 @@
 package whmcsgo
 
-// ProjectService handles .... related
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ProjectService handles communication with the Project Management related
 // methods of the WHMCS API.
 //
 // WHMCS API docs: https://developers.whmcs.com/api/api-index/
 type ProjectService struct {
 	client *Client
 }
+
+// Project from WHMCS Project Management
+type Project struct {
+	ID           int       `json:"id"`
+	UserID       int       `json:"userid"`
+	Title        string    `json:"title"`
+	TicketIDs    string    `json:"ticketids"`  // Comma separated list of ticket ids
+	InvoiceIDs   string    `json:"invoiceids"` // Comma separated list of invoice ids
+	AdminID      int       `json:"adminid"`
+	Status       string    `json:"status"`
+	Created      WHCMSdate `json:"created"`
+	DueDate      WHCMSdate `json:"duedate"`
+	Completed    int       `json:"completed"`
+	LastModified WHCMSdate `json:"lastmodified"`
+}
+
+func (p Project) String() string {
+	return Stringify(p)
+}
+
+// ProjectTask a task within a project
+type ProjectTask struct {
+	ID        int       `json:"id"`
+	ProjectID int       `json:"projectid"`
+	AdminID   int       `json:"adminid"`
+	Task      string    `json:"task"`
+	Notes     string    `json:"notes"`
+	Completed int       `json:"completed"`
+	Created   WHCMSdate `json:"created"`
+	DueDate   WHCMSdate `json:"duedate"`
+	Billed    int       `json:"billed"`
+	TimeLogs  struct {
+		TimeLog []ProjectTimeLog `json:"timelog"`
+	} `json:"timelogs"`
+}
+
+// ProjectTimeLog the time recorded against a task by a task timer
+type ProjectTimeLog struct {
+	ID        int   `json:"id"`
+	TaskID    int   `json:"taskid"`
+	ProjectID int   `json:"projectid"`
+	AdminID   int   `json:"adminid"`
+	Start     int64 `json:"start"` // Unix time the timer was started
+	End       int64 `json:"end"`   // Unix time the timer was ended, 0 while running
+}
+
+// Duration of the time log, running timers are not counted
+func (l ProjectTimeLog) Duration() time.Duration {
+	if l.End <= l.Start {
+		return 0
+	}
+	return time.Duration(l.End-l.Start) * time.Second
+}
+
+// LoggedHours the total hours recorded against the task by completed timers
+func (t ProjectTask) LoggedHours() float64 {
+	var d time.Duration
+	for _, l := range t.TimeLogs.TimeLog {
+		d += l.Duration()
+	}
+	return d.Hours()
+}
+
+/*
+BillableItemParms builds the parameters for BillingService.AddBillableItem
+from the time logged against the task, charged at hourlyRate.
+
+invoiceAction is one of noinvoice, nextcron, nextinvoice, duedate or recur.
+*/
+func (t ProjectTask) BillableItemParms(clientID int, hourlyRate float64, invoiceAction string) map[string]string {
+	hours := t.LoggedHours()
+
+	return map[string]string{
+		"clientid":      fmt.Sprintf("%d", clientID),
+		"description":   t.Task,
+		"hours":         fmt.Sprintf("%.2f", hours),
+		"amount":        fmt.Sprintf("%.2f", hours*hourlyRate),
+		"invoiceaction": invoiceAction,
+	}
+}
+
+// ProjectMessage a message posted to a project
+type ProjectMessage struct {
+	ID        int       `json:"id"`
+	ProjectID int       `json:"projectid"`
+	Date      WHCMSdate `json:"date"`
+	Message   string    `json:"message"`
+	AdminID   int       `json:"adminid"`
+}
+
+// ProjectDetails the project along with its tasks and messages
+type ProjectDetails struct {
+	Result  string  `json:"result"`
+	Project Project `json:"projectinfo"`
+	Tasks   struct {
+		Task []ProjectTask `json:"task"`
+	} `json:"tasks"`
+	Messages struct {
+		Message []ProjectMessage `json:"message"`
+	} `json:"messages"`
+}
+
+// ProjectsReply object from WHMCS
+type ProjectsReply struct {
+	Projects struct {
+		Project []Project `json:"project"`
+	} `json:"projects"`
+	Numreturned  int    `json:"numreturned"`
+	Result       string `json:"result"`
+	Startnumber  int    `json:"startnumber"`
+	Totalresults int    `json:"totalresults"`
+}
+
+// ProjectReply the status after creating or updating a project, task or message
+type ProjectReply struct {
+	Result    string `json:"result"`    // The result of the operation: success or error
+	Message   string `json:"message"`   // The error message, if any
+	ProjectID int    `json:"projectid"` // The ID of the project
+	TaskID    int    `json:"taskid"`    // The ID of the task, if any
+	TimerID   int    `json:"timerid"`   // The ID of the timer, if any
+}
+
+// ProjectRequest the project to be created or updated
+type ProjectRequest struct {
+	Title      string    // The title of the project, required on create
+	AdminID    int       // The admin the project is assigned to, required on create
+	UserID     int       // The client the project is for
+	Status     string    // The status of the project
+	Created    time.Time // The date the project was created
+	DueDate    time.Time // The date the project is due
+	Completed  *bool     // Is the project completed, nil leaves it unchanged
+	TicketIDs  []int     // The tickets associated with the project
+	InvoiceIDs []int     // The invoices associated with the project
+}
+
+func (p ProjectRequest) toParams() map[string]string {
+	parms := map[string]string{}
+	layout := "2006-01-02"
+
+	if len(p.Title) > 0 {
+		parms["title"] = p.Title
+	}
+	if p.AdminID > 0 {
+		parms["adminid"] = fmt.Sprintf("%d", p.AdminID)
+	}
+	if p.UserID > 0 {
+		parms["userid"] = fmt.Sprintf("%d", p.UserID)
+	}
+	if len(p.Status) > 0 {
+		parms["status"] = p.Status
+	}
+	if !p.Created.IsZero() {
+		parms["created"] = p.Created.Format(layout)
+	}
+	if !p.DueDate.IsZero() {
+		parms["duedate"] = p.DueDate.Format(layout)
+	}
+	if p.Completed != nil {
+		parms["completed"] = FormatBool(*p.Completed)
+	}
+	if len(p.TicketIDs) > 0 {
+		parms["ticketids"] = joinInts(p.TicketIDs)
+	}
+	if len(p.InvoiceIDs) > 0 {
+		parms["invoiceids"] = joinInts(p.InvoiceIDs)
+	}
+
+	return parms
+}
+
+// joinInts formats ids as a comma separated list
+func joinInts(ids []int) string {
+	s := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s = append(s, fmt.Sprintf("%d", id))
+	}
+	return strings.Join(s, ",")
+}
+
+// projectAction sends a project management action and decodes the reply
+func (s *ProjectService) projectAction(action string, parms map[string]string) (*ProjectReply, *Response, error) {
+	r := new(ProjectReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: action}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+/*
+CreateProject Creates a new project
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/createproject/
+
+Request Parameters
+
+title
+	string	The title of the new project	Required
+adminid
+	int	The admin to assign the project to	Required
+userid
+	int	The client the project is for	Optional
+status
+	string	The status of the project	Optional
+created
+	\Carbon\Carbon	The date the project was created Y-m-d	Optional
+duedate
+	\Carbon\Carbon	The date the project is due Y-m-d	Optional
+completed
+	bool	Is the project completed	Optional
+ticketids
+	string	A comma separated list of ticket ids	Optional
+invoiceids
+	string	A comma separated list of invoice ids	Optional
+*/
+func (s *ProjectService) CreateProject(project ProjectRequest) (*ProjectReply, *Response, error) {
+	if len(project.Title) == 0 || project.AdminID < 1 {
+		return nil, nil, errors.New("title and admin ID required to create a project")
+	}
+
+	return s.projectAction("CreateProject", project.toParams())
+}
+
+/*
+UpdateProject Updates a project
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/updateproject/
+
+Request Parameters
+
+projectid
+	int	The project to update	Required
+
+Other parameters are the same as CreateProject and only set fields are sent.
+*/
+func (s *ProjectService) UpdateProject(projectID int, project ProjectRequest) (*ProjectReply, *Response, error) {
+	if projectID < 1 {
+		return nil, nil, errors.New("project ID required to update a project")
+	}
+
+	parms := project.toParams()
+	parms["projectid"] = fmt.Sprintf("%d", projectID)
+
+	return s.projectAction("UpdateProject", parms)
+}
+
+/*
+GetProjects Obtain projects matching the passed criteria
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getprojects/
+
+Request Parameters
+
+limitstart
+	int	The offset for the returned project data (default: 0)	Optional
+limitnum
+	int	The number of records to return (default: 25)	Optional
+userid
+	int	Find projects for a specific client id	Optional
+title
+	string	Find projects with a specific title	Optional
+ticketids
+	string	Find projects with specific ticket ids	Optional
+invoiceids
+	string	Find projects with specific invoice ids	Optional
+status
+	string	Find projects with a specific status	Optional
+adminid
+	int	Find projects assigned to a specific admin	Optional
+created
+	\Carbon\Carbon	Find projects created on a specific date	Optional
+duedate
+	\Carbon\Carbon	Find projects due on a specific date	Optional
+completed
+	bool	Find completed projects	Optional
+lastmodified
+	\Carbon\Carbon	Find projects last modified on a specific date	Optional
+*/
+func (s *ProjectService) GetProjects(parms map[string]string) (*ProjectsReply, *Response, error) {
+	r := new(ProjectsReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetProjects"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+/*
+GetProject Obtain a project along with its tasks and messages
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getproject/
+
+Request Parameters
+
+projectid
+	int	The id of the project to retrieve	Required
+*/
+func (s *ProjectService) GetProject(projectID int) (*ProjectDetails, *Response, error) {
+	if projectID < 1 {
+		return nil, nil, errors.New("project ID required to get a project")
+	}
+
+	r := new(ProjectDetails)
+	parms := map[string]string{"projectid": fmt.Sprintf("%d", projectID)}
+
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetProject"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// ProjectTaskRequest the task to be added or updated
+type ProjectTaskRequest struct {
+	Task      string    // The task summary, required when adding
+	AdminID   int       // The admin the task is assigned to
+	DueDate   time.Time // The date the task is due
+	Notes     string    // The notes for the task
+	Completed *bool     // Is the task completed, nil leaves it unchanged
+	Billed    bool      // Has the task been billed, only used when adding
+}
+
+func (t ProjectTaskRequest) toParams() map[string]string {
+	parms := map[string]string{}
+
+	if len(t.Task) > 0 {
+		parms["task"] = t.Task
+	}
+	if t.AdminID > 0 {
+		parms["adminid"] = fmt.Sprintf("%d", t.AdminID)
+	}
+	if !t.DueDate.IsZero() {
+		parms["duedate"] = t.DueDate.Format("2006-01-02")
+	}
+	if len(t.Notes) > 0 {
+		parms["notes"] = t.Notes
+	}
+	if t.Completed != nil {
+		parms["completed"] = FormatBool(*t.Completed)
+	}
+
+	return parms
+}
+
+/*
+AddProjectTask Adds a task to a project
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/addprojecttask/
+
+Request Parameters
+
+projectid
+	int	The project to add the task to	Required
+duedate
+	\Carbon\Carbon	The due date for the task Y-m-d	Optional
+adminid
+	int	The admin to assign the task to	Optional
+task
+	string	The task summary	Required
+notes
+	string	The notes for the task	Optional
+completed
+	bool	Is the task completed	Optional
+billed
+	bool	Has the task been billed	Optional
+*/
+func (s *ProjectService) AddProjectTask(projectID int, task ProjectTaskRequest) (*ProjectReply, *Response, error) {
+	if projectID < 1 || len(task.Task) == 0 {
+		return nil, nil, errors.New("project ID and task required to add a project task")
+	}
+
+	parms := task.toParams()
+	parms["projectid"] = fmt.Sprintf("%d", projectID)
+	if task.Billed {
+		parms["billed"] = FormatBool(task.Billed)
+	}
+
+	return s.projectAction("AddProjectTask", parms)
+}
+
+/*
+UpdateProjectTask Updates a project task
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/updateprojecttask/
+
+Request Parameters
+
+taskid
+	int	The task to update	Required
+projectid
+	int	The project the task belongs to	Optional
+
+Other parameters are the same as AddProjectTask and only set fields are sent.
+*/
+func (s *ProjectService) UpdateProjectTask(taskID int, task ProjectTaskRequest) (*ProjectReply, *Response, error) {
+	if taskID < 1 {
+		return nil, nil, errors.New("task ID required to update a project task")
+	}
+
+	parms := task.toParams()
+	parms["taskid"] = fmt.Sprintf("%d", taskID)
+
+	return s.projectAction("UpdateProjectTask", parms)
+}
+
+/*
+DeleteProjectTask Deletes a project task
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/deleteprojecttask/
+
+Request Parameters
+
+projectid
+	int	The project the task belongs to	Required
+taskid
+	int	The task to delete	Required
+*/
+func (s *ProjectService) DeleteProjectTask(projectID, taskID int) (*ProjectReply, *Response, error) {
+	if projectID < 1 || taskID < 1 {
+		return nil, nil, errors.New("project ID and task ID required to delete a project task")
+	}
+
+	parms := map[string]string{
+		"projectid": fmt.Sprintf("%d", projectID),
+		"taskid":    fmt.Sprintf("%d", taskID),
+	}
+
+	return s.projectAction("DeleteProjectTask", parms)
+}
+
+/*
+AddProjectMessage Adds a message to a project
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/addprojectmessage/
+
+Request Parameters
+
+projectid
+	int	The project to add the message to	Required
+message
+	string	The message to add	Required
+adminid
+	int	The admin posting the message, defaults to the API admin	Optional
+*/
+func (s *ProjectService) AddProjectMessage(projectID int, message string, adminID int) (*ProjectReply, *Response, error) {
+	if projectID < 1 || len(message) == 0 {
+		return nil, nil, errors.New("project ID and message required to add a project message")
+	}
+
+	parms := map[string]string{
+		"projectid": fmt.Sprintf("%d", projectID),
+		"message":   message,
+	}
+	if adminID > 0 {
+		parms["adminid"] = fmt.Sprintf("%d", adminID)
+	}
+
+	return s.projectAction("AddProjectMessage", parms)
+}
+
+/*
+StartTaskTimer Starts a timer against a project task
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/starttasktimer/
+
+Request Parameters
+
+timerid
+	int	The id of an existing timer to restart	Optional
+projectid
+	int	The project the task belongs to	Required
+adminid
+	int	The admin the timer is for, defaults to the API admin	Optional
+start_time
+	int	The unix time the timer started, defaults to now	Optional
+taskid
+	int	The task the timer is for	Required
+*/
+func (s *ProjectService) StartTaskTimer(projectID, taskID, adminID int, start time.Time) (*ProjectReply, *Response, error) {
+	if projectID < 1 || taskID < 1 {
+		return nil, nil, errors.New("project ID and task ID required to start a task timer")
+	}
+
+	parms := map[string]string{
+		"projectid": fmt.Sprintf("%d", projectID),
+		"taskid":    fmt.Sprintf("%d", taskID),
+	}
+	if adminID > 0 {
+		parms["adminid"] = fmt.Sprintf("%d", adminID)
+	}
+	if !start.IsZero() {
+		parms["start_time"] = fmt.Sprintf("%d", start.Unix())
+	}
+
+	return s.projectAction("StartTaskTimer", parms)
+}
+
+/*
+EndTaskTimer Ends a running project task timer
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/endtasktimer/
+
+Request Parameters
+
+timerid
+	int	The timer to end	Required
+projectid
+	int	The project the timer belongs to	Required
+adminid
+	int	The admin the timer is for	Optional
+end_time
+	int	The unix time the timer ended, defaults to now	Optional
+*/
+func (s *ProjectService) EndTaskTimer(projectID, timerID, adminID int, end time.Time) (*ProjectReply, *Response, error) {
+	if projectID < 1 || timerID < 1 {
+		return nil, nil, errors.New("project ID and timer ID required to end a task timer")
+	}
+
+	parms := map[string]string{
+		"projectid": fmt.Sprintf("%d", projectID),
+		"timerid":   fmt.Sprintf("%d", timerID),
+	}
+	if adminID > 0 {
+		parms["adminid"] = fmt.Sprintf("%d", adminID)
+	}
+	if !end.IsZero() {
+		parms["end_time"] = fmt.Sprintf("%d", end.Unix())
+	}
+
+	return s.projectAction("EndTaskTimer", parms)
+}
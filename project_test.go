@@ -0,0 +1,176 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestProjectRequest_toParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		project ProjectRequest
+		want    map[string]string
+	}{
+		{
+			name: "Create",
+			project: ProjectRequest{
+				Title:      "Migration",
+				AdminID:    1,
+				UserID:     3,
+				Status:     "Pending",
+				DueDate:    time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+				TicketIDs:  []int{4, 5},
+				InvoiceIDs: []int{31},
+			},
+			want: map[string]string{
+				"title":      "Migration",
+				"adminid":    "1",
+				"userid":     "3",
+				"status":     "Pending",
+				"duedate":    "2021-04-01",
+				"ticketids":  "4,5",
+				"invoiceids": "31",
+			},
+		},
+		{
+			name:    "Completed",
+			project: ProjectRequest{Completed: Bool(true)},
+			want:    map[string]string{"completed": "1"},
+		},
+		{
+			name:    "Not completed",
+			project: ProjectRequest{Completed: Bool(false)},
+			want:    map[string]string{"completed": "0"},
+		},
+		{
+			name:    "Nothing set",
+			project: ProjectRequest{},
+			want:    map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.project.toParams(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProjectRequest.toParams() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectTaskRequest_toParams(t *testing.T) {
+	tests := []struct {
+		name string
+		task ProjectTaskRequest
+		want map[string]string
+	}{
+		{
+			name: "Task",
+			task: ProjectTaskRequest{Task: "Copy mailboxes", AdminID: 2, Notes: "Overnight", DueDate: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)},
+			want: map[string]string{"task": "Copy mailboxes", "adminid": "2", "notes": "Overnight", "duedate": "2021-04-01"},
+		},
+		{
+			name: "Reopen",
+			task: ProjectTaskRequest{Completed: Bool(false)},
+			want: map[string]string{"completed": "0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.task.toParams(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProjectTaskRequest.toParams() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectService_UpdateProjectTask(t *testing.T) {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		if req.PostForm.Get("taskid") != "9" || req.PostForm.Get("completed") != "0" {
+			t.Errorf("UpdateProjectTask got params = %v", req.PostForm)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"result":"success","taskid":9}`)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &ProjectService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	got, _, err := s.UpdateProjectTask(9, ProjectTaskRequest{Completed: Bool(false)})
+	if err != nil {
+		t.Fatalf("ProjectService.UpdateProjectTask() error = %v", err)
+	}
+	if got.TaskID != 9 {
+		t.Errorf("ProjectService.UpdateProjectTask() TaskID = %d, want 9", got.TaskID)
+	}
+
+	if _, _, err := s.UpdateProjectTask(0, ProjectTaskRequest{}); err == nil {
+		t.Error("ProjectService.UpdateProjectTask() expected an error without a task ID")
+	}
+}
+
+func TestProjectService_GetProject(t *testing.T) {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		body := `{"result":"success",` +
+			`"projectinfo":{"id":7,"userid":3,"title":"Migration","ticketids":"4,5","adminid":1,"status":"Pending","duedate":"2021-04-01","completed":0},` +
+			`"tasks":{"task":[{"id":9,"projectid":7,"task":"Copy mailboxes","completed":1,` +
+			`"timelogs":{"timelog":[{"id":1,"taskid":9,"start":1617235200,"end":1617242400},{"id":2,"taskid":9,"start":1617249600,"end":0}]}}]},` +
+			`"messages":{"message":[{"id":11,"projectid":7,"message":"Started","adminid":1}]}}`
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &ProjectService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	got, _, err := s.GetProject(7)
+	if err != nil {
+		t.Fatalf("ProjectService.GetProject() error = %v", err)
+	}
+	if got.Project.ID != 7 || got.Project.Title != "Migration" || got.Project.UserID != 3 || got.Project.TicketIDs != "4,5" {
+		t.Errorf("ProjectService.GetProject() Project = %+v", got.Project)
+	}
+	if got.Project.DueDate.Format("2006-01-02") != "2021-04-01" {
+		t.Errorf("ProjectService.GetProject() DueDate = %v", got.Project.DueDate)
+	}
+	if len(got.Tasks.Task) != 1 || got.Tasks.Task[0].LoggedHours() != 2 {
+		t.Errorf("ProjectService.GetProject() Tasks = %+v", got.Tasks.Task)
+	}
+	if len(got.Messages.Message) != 1 || got.Messages.Message[0].Message != "Started" {
+		t.Errorf("ProjectService.GetProject() Messages = %+v", got.Messages.Message)
+	}
+}
+
+func TestProjectService_GetProjects(t *testing.T) {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		if req.PostForm.Get("userid") != "3" {
+			t.Errorf("GetProjects got params = %v", req.PostForm)
+		}
+		body := `{"result":"success","totalresults":2,"startnumber":0,"numreturned":2,"projects":{"project":[` +
+			`{"id":7,"userid":3,"title":"Migration","status":"Pending"},{"id":8,"userid":3,"title":"Audit","status":"Completed","completed":1}]}}`
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &ProjectService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	got, _, err := s.GetProjects(map[string]string{"userid": "3"})
+	if err != nil {
+		t.Fatalf("ProjectService.GetProjects() error = %v", err)
+	}
+	if got.Totalresults != 2 || len(got.Projects.Project) != 2 || got.Projects.Project[1].Title != "Audit" || got.Projects.Project[1].Completed != 1 {
+		t.Errorf("ProjectService.GetProjects() got = %+v", got)
+	}
+}
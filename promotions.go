@@ -0,0 +1,151 @@
+package whmcsgo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Promotion types returned by GetPromotions
+const (
+	PromoPercentage    = "Percentage"
+	PromoFixedAmount   = "Fixed Amount"
+	PromoPriceOverride = "Price Override"
+	PromoFreeSetup     = "Free Setup"
+)
+
+// Promotion a promotion code configured in WHMCS
+type Promotion struct {
+	ID               int       `json:"id"`
+	Code             string    `json:"code"`
+	Type             string    `json:"type"`      // Percentage, Fixed Amount, Price Override or Free Setup
+	Recurring        WHMCSbool `json:"recurring"` // Does the discount apply to renewals
+	Value            string    `json:"value"`
+	Cycles           string    `json:"cycles"`    // Comma separated billing cycles, empty for all
+	AppliesTo        string    `json:"appliesto"` // Comma separated product IDs, empty for all
+	Requires         string    `json:"requires"`
+	RequiresExisting WHMCSbool `json:"requiresexisting"`
+	StartDate        WHCMSdate `json:"startdate"`
+	ExpirationDate   WHCMSdate `json:"expirationdate"`
+	MaxUses          int       `json:"maxuses"` // 0 for unlimited
+	Uses             int       `json:"uses"`
+	LifetimePromo    WHMCSbool `json:"lifetimepromo"`
+	ApplyOnce        WHMCSbool `json:"applyonce"`
+	NewSignups       WHMCSbool `json:"newsignups"`
+	ExistingClient   WHMCSbool `json:"existingclient"`
+	OncePerClient    WHMCSbool `json:"onceperclient"`
+	RecurFor         int       `json:"recurfor"` // The number of renewals the discount applies to, 0 for all
+	Upgrades         WHMCSbool `json:"upgrades"`
+	Notes            string    `json:"notes"`
+}
+
+func (p Promotion) String() string {
+	return Stringify(p)
+}
+
+// PromotionsReply object from WHMCS
+type PromotionsReply struct {
+	Result       string `json:"result"`
+	Totalresults int    `json:"totalresults"`
+	Promotions   struct {
+		Promotion []Promotion `json:"promotion"`
+	} `json:"promotions"`
+}
+
+/*
+GetPromotions Obtain promotions matching the passed criteria
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getpromotions/
+
+Request Parameters
+
+code
+	string	Retrieve a specific promotion code. Do not pass to retrieve all	Optional
+*/
+func (s *OrdersService) GetPromotions(code string) (*PromotionsReply, *Response, error) {
+	r := new(PromotionsReply)
+	parms := map[string]string{}
+	if len(code) > 0 {
+		parms["code"] = code
+	}
+
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetPromotions"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// listContains reports whether the comma separated list contains v, ignoring case and spaces
+func listContains(list, v string) bool {
+	for _, item := range strings.Split(list, ",") {
+		if strings.EqualFold(strings.TrimSpace(item), strings.TrimSpace(v)) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Validate checks locally whether the promotion can be applied to product pid on
+billingCycle at the time now. It mirrors the checks WHMCS performs when
+AddOrder is called with a promocode, other than the client specific rules.
+*/
+func (p Promotion) Validate(pid int, billingCycle string, now time.Time) error {
+	if !p.StartDate.IsZero() && now.Before(p.StartDate.Time) {
+		return fmt.Errorf("promotion %s has not started", p.Code)
+	}
+
+	// The expiration date is inclusive of the whole day
+	if !p.ExpirationDate.IsZero() && !now.Before(p.ExpirationDate.AddDate(0, 0, 1)) {
+		return fmt.Errorf("promotion %s has expired", p.Code)
+	}
+
+	if p.MaxUses > 0 && p.Uses >= p.MaxUses {
+		return fmt.Errorf("promotion %s has reached its maximum uses", p.Code)
+	}
+
+	if len(strings.TrimSpace(p.AppliesTo)) > 0 && !listContains(p.AppliesTo, strconv.Itoa(pid)) {
+		return fmt.Errorf("promotion %s does not apply to product %d", p.Code, pid)
+	}
+
+	if len(strings.TrimSpace(p.Cycles)) > 0 && !listContains(p.Cycles, billingCycle) {
+		return fmt.Errorf("promotion %s does not apply to the %s billing cycle", p.Code, billingCycle)
+	}
+
+	return nil
+}
+
+/*
+ValidatePromotion looks up the promotion code and checks whether it applies to
+product pid on billingCycle before it is passed to AddOrder as the promocode.
+*/
+func (s *OrdersService) ValidatePromotion(code string, pid int, billingCycle string) (*Promotion, error) {
+	if len(code) == 0 {
+		return nil, errors.New("promotion code required to validate a promotion")
+	}
+
+	r, _, err := s.GetPromotions(code)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range r.Promotions.Promotion {
+		if strings.EqualFold(p.Code, code) {
+			if err := p.Validate(pid, billingCycle, time.Now()); err != nil {
+				return &p, err
+			}
+			return &p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("promotion %s not found", code)
+}
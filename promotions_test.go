@@ -0,0 +1,44 @@
+package whmcsgo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestPromotion_Validate(t *testing.T) {
+	var p Promotion
+	body := `{"id":1,"code":"SAVE10","type":"Percentage","recurring":1,"value":"10.00","cycles":"Monthly,Annually",` +
+		`"appliesto":"1,3","startdate":"2020-01-01","expirationdate":"2020-12-31","maxuses":5,"uses":4}`
+	if err := json.Unmarshal([]byte(body), &p); err != nil {
+		t.Fatalf("json.Unmarshal failed: %v", err)
+	}
+
+	during := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		promo   Promotion
+		pid     int
+		cycle   string
+		now     time.Time
+		wantErr bool
+	}{
+		{"applies", p, 3, "Annually", during, false},
+		{"last day", p, 3, "Annually", time.Date(2020, 12, 31, 12, 0, 0, 0, time.UTC), false},
+		{"expired", p, 3, "Annually", time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC), true},
+		{"not started", p, 3, "Annually", time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{"other product", p, 2, "Annually", during, true},
+		{"other cycle", p, 1, "Quarterly", during, true},
+		{"used up", func() Promotion { q := p; q.Uses = 5; return q }(), 1, "Monthly", during, true},
+		{"no restrictions", Promotion{Code: "ANY"}, 9, "Biennially", during, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.promo.Validate(tt.pid, tt.cycle, tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Promotion.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
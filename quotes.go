@@ -0,0 +1,323 @@
+package whmcsgo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Quote stages accepted by CreateQuote and UpdateQuote
+const (
+	QuoteDraft     = "Draft"
+	QuoteDelivered = "Delivered"
+	QuoteOnHold    = "On Hold"
+	QuoteAccepted  = "Accepted"
+	QuoteLost      = "Lost"
+	QuoteDead      = "Dead"
+)
+
+// Quote from WHMCS
+type Quote struct {
+	ID            int       `json:"id"`
+	Subject       string    `json:"subject"`
+	Stage         string    `json:"stage"`
+	ValidUntil    WHCMSdate `json:"validuntil"`
+	UserID        int       `json:"userid"`
+	FirstName     string    `json:"firstname"`
+	LastName      string    `json:"lastname"`
+	CompanyName   string    `json:"companyname"`
+	Email         string    `json:"email"`
+	Currency      int       `json:"currency"`
+	Subtotal      string    `json:"subtotal"`
+	Tax1          string    `json:"tax1"`
+	Tax2          string    `json:"tax2"`
+	Total         string    `json:"total"`
+	Proposal      string    `json:"proposal"`
+	CustomerNotes string    `json:"customernotes"`
+	AdminNotes    string    `json:"adminnotes"`
+	DateCreated   WHCMSdate `json:"datecreated"`
+	LastModified  WHCMSdate `json:"lastmodified"`
+	DateSent      WHCMSdate `json:"datesent"`
+	DateAccepted  WHCMSdate `json:"dateaccepted"`
+	Items         struct {
+		Item []QuoteItem `json:"item"`
+	} `json:"items"`
+}
+
+func (q Quote) String() string {
+	return Stringify(q)
+}
+
+// QuoteItem a line item on a quote
+type QuoteItem struct {
+	ID          int       `json:"id"`
+	QuoteID     int       `json:"quoteid"`
+	Description string    `json:"description"`
+	Quantity    string    `json:"quantity"`
+	UnitPrice   string    `json:"unitprice"`
+	Discount    string    `json:"discount"` // The discount as a percentage
+	Taxable     WHMCSbool `json:"taxable"`
+}
+
+// InvoiceLineItems converts the quote items into invoice line items, applying
+// the discount of each item to its unit price
+func (q Quote) InvoiceLineItems() []InvoiceLineItems {
+	items := make([]InvoiceLineItems, 0, len(q.Items.Item))
+	for i, qi := range q.Items.Item {
+		qty, _ := strconv.ParseFloat(qi.Quantity, 64)
+		price, _ := strconv.ParseFloat(qi.UnitPrice, 64)
+		discount, _ := strconv.ParseFloat(qi.Discount, 64)
+
+		items = append(items, InvoiceLineItems{
+			ItemOrder:       i + 1,
+			ItemDescription: qi.Description,
+			Quantity:        qty,
+			UnitPrice:       price * (100 - discount) / 100,
+			ItemTaxed:       bool(qi.Taxable),
+		})
+	}
+	return items
+}
+
+// QuotesReply object from WHMCS
+type QuotesReply struct {
+	Quotes struct {
+		Quote []Quote `json:"quote"`
+	} `json:"quotes"`
+	Numreturned  int    `json:"numreturned"`
+	Result       string `json:"result"`
+	Startnumber  int    `json:"startnumber"`
+	Totalresults int    `json:"totalresults"`
+}
+
+// QuoteReply the status after creating, updating or accepting a quote
+type QuoteReply struct {
+	Result    string `json:"result"`    // The result of the operation: success or error
+	Message   string `json:"message"`   // The error message, if any
+	QuoteID   int    `json:"quoteid"`   // The ID of the quote
+	InvoiceID int    `json:"invoiceid"` // The ID of the invoice created when the quote was accepted
+}
+
+// QuoteRequest the quote to be created or updated
+type QuoteRequest struct {
+	Subject       string             // The subject of the quote, required on create
+	Stage         string             // The stage of the quote, required on create
+	ValidUntil    time.Time          // The date the quote is valid until, required on create
+	DateCreated   time.Time          // The date the quote was created
+	UserID        int                // The client the quote is for
+	CustomerNotes string             // The notes shown to the customer
+	AdminNotes    string             // The notes shown to admins
+	Proposal      string             // The proposal text of the quote
+	LineItems     []InvoiceLineItems // The quote line items, the amount is used when no unit price is set
+}
+
+func (q QuoteRequest) toParams() (map[string]string, error) {
+	parms := map[string]string{}
+	layout := "2006-01-02"
+
+	switch q.Stage {
+	case "":
+	case QuoteDraft, QuoteDelivered, QuoteOnHold, QuoteAccepted, QuoteLost, QuoteDead:
+		parms["stage"] = q.Stage
+	default:
+		return nil, fmt.Errorf("unsupported quote stage: %s", q.Stage)
+	}
+
+	if len(q.Subject) > 0 {
+		parms["subject"] = q.Subject
+	}
+	if !q.ValidUntil.IsZero() {
+		parms["validuntil"] = q.ValidUntil.Format(layout)
+	}
+	if !q.DateCreated.IsZero() {
+		parms["datecreated"] = q.DateCreated.Format(layout)
+	}
+	if q.UserID > 0 {
+		parms["userid"] = fmt.Sprintf("%d", q.UserID)
+	}
+	if len(q.CustomerNotes) > 0 {
+		parms["customernotes"] = q.CustomerNotes
+	}
+	if len(q.AdminNotes) > 0 {
+		parms["adminnotes"] = q.AdminNotes
+	}
+	if len(q.Proposal) > 0 {
+		parms["proposal"] = q.Proposal
+	}
+	if len(q.LineItems) > 0 {
+		parms["lineitems"] = quoteLineItemsToParam(q.LineItems)
+	}
+
+	return parms, nil
+}
+
+// quoteLineItemsToParam encodes the line items as the serialized array WHMCS expects
+func quoteLineItemsToParam(items []InvoiceLineItems) string {
+	arr := make(phpArray, 0, len(items))
+	for i, li := range items {
+		arr = append(arr, phpPair{Key: i, Value: phpArray{
+			{Key: "desc", Value: li.ItemDescription},
+			{Key: "qty", Value: li.quantity()},
+			{Key: "up", Value: roundCents(li.unitPrice())},
+			{Key: "discount", Value: 0},
+			{Key: "taxable", Value: li.taxed()},
+		}})
+	}
+	return serializeBase64(arr)
+}
+
+/*
+CreateQuote Create a new quote
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/createquote/
+
+Request Parameters
+
+subject
+	string	The subject of the quote	Required
+stage
+	string	The stage of the quote: Draft, Delivered, On Hold, Accepted, Lost, Dead	Required
+validuntil
+	\Carbon\Carbon	The date the quote is valid until Y-m-d	Required
+datecreated
+	\Carbon\Carbon	The date the quote was created Y-m-d	Optional
+lineitems
+	string	Base64 encoded serialized array of line items	Optional
+userid
+	int	The client the quote is for	Optional
+customernotes
+	string	The notes shown to the customer	Optional
+adminnotes
+	string	The notes shown to admins	Optional
+proposal
+	string	The proposal text of the quote	Optional
+*/
+func (s *BillingService) CreateQuote(quote QuoteRequest) (*QuoteReply, *Response, error) {
+	if len(quote.Subject) == 0 || len(quote.Stage) == 0 || quote.ValidUntil.IsZero() {
+		return nil, nil, errors.New("subject, stage and valid until date required to create a quote")
+	}
+
+	parms, err := quote.toParams()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.quoteAction("CreateQuote", parms)
+}
+
+/*
+UpdateQuote Update an existing quote
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/updatequote/
+
+Request Parameters
+
+quoteid
+	int	The ID of the quote to update	Required
+
+Other parameters are the same as CreateQuote and only set fields are sent.
+Passing line items replaces the items on the quote.
+*/
+func (s *BillingService) UpdateQuote(quoteID int, quote QuoteRequest) (*QuoteReply, *Response, error) {
+	if quoteID < 1 {
+		return nil, nil, errors.New("quote ID required to update a quote")
+	}
+
+	parms, err := quote.toParams()
+	if err != nil {
+		return nil, nil, err
+	}
+	parms["quoteid"] = fmt.Sprintf("%d", quoteID)
+
+	return s.quoteAction("UpdateQuote", parms)
+}
+
+/*
+GetQuotes Obtain quotes matching the passed criteria
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getquotes/
+
+Request Parameters
+
+limitstart
+	int	The offset for the returned quote data (default: 0)	Optional
+limitnum
+	int	The number of records to return (default: 25)	Optional
+quoteid
+	int	Obtain a specific quote	Optional
+userid
+	int	Find quotes for a specific client id	Optional
+subject
+	string	Find quotes with a specific subject	Optional
+stage
+	string	Find quotes at a specific stage	Optional
+datecreated
+	\Carbon\Carbon	Find quotes created on a specific date	Optional
+lastmodified
+	\Carbon\Carbon	Find quotes last modified on a specific date	Optional
+validuntil
+	\Carbon\Carbon	Find quotes valid until a specific date	Optional
+*/
+func (s *BillingService) GetQuotes(parms map[string]string) (*QuotesReply, *Response, error) {
+	r := new(QuotesReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetQuotes"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// SendQuote Send a quote to the client
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/sendquote/
+func (s *BillingService) SendQuote(quoteID int) (*QuoteReply, *Response, error) {
+	if quoteID < 1 {
+		return nil, nil, errors.New("quote ID required to send a quote")
+	}
+	return s.quoteAction("SendQuote", map[string]string{"quoteid": fmt.Sprintf("%d", quoteID)})
+}
+
+// AcceptQuote Accept a quote, converting it to an invoice. The invoice ID is returned in the reply
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/acceptquote/
+func (s *BillingService) AcceptQuote(quoteID int) (*QuoteReply, *Response, error) {
+	if quoteID < 1 {
+		return nil, nil, errors.New("quote ID required to accept a quote")
+	}
+	return s.quoteAction("AcceptQuote", map[string]string{"quoteid": fmt.Sprintf("%d", quoteID)})
+}
+
+// DeleteQuote Delete a quote
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/deletequote/
+func (s *BillingService) DeleteQuote(quoteID int) (*QuoteReply, *Response, error) {
+	if quoteID < 1 {
+		return nil, nil, errors.New("quote ID required to delete a quote")
+	}
+	return s.quoteAction("DeleteQuote", map[string]string{"quoteid": fmt.Sprintf("%d", quoteID)})
+}
+
+// quoteAction sends a quote action and decodes the reply
+func (s *BillingService) quoteAction(action string, parms map[string]string) (*QuoteReply, *Response, error) {
+	r := new(QuoteReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: action}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
@@ -0,0 +1,208 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestQuoteRequest_toParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		quote   QuoteRequest
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "Create",
+			quote: QuoteRequest{
+				Subject:       "Website rebuild",
+				Stage:         QuoteOnHold,
+				ValidUntil:    time.Date(2021, 4, 30, 0, 0, 0, 0, time.UTC),
+				DateCreated:   time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+				UserID:        3,
+				CustomerNotes: "Thanks",
+				AdminNotes:    "Follow up",
+				Proposal:      "Rebuild in two stages",
+			},
+			want: map[string]string{
+				"subject":       "Website rebuild",
+				"stage":         "On Hold",
+				"validuntil":    "2021-04-30",
+				"datecreated":   "2021-04-01",
+				"userid":        "3",
+				"customernotes": "Thanks",
+				"adminnotes":    "Follow up",
+				"proposal":      "Rebuild in two stages",
+			},
+		},
+		{
+			name:  "Only set fields",
+			quote: QuoteRequest{Stage: QuoteAccepted},
+			want:  map[string]string{"stage": "Accepted"},
+		},
+		{
+			name:    "Unknown stage",
+			quote:   QuoteRequest{Stage: "Won"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.quote.toParams()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("QuoteRequest.toParams() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("QuoteRequest.toParams() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_quoteLineItemsToParam(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []InvoiceLineItems
+		want  string
+	}{
+		{
+			name:  "Quantity and unit price",
+			items: []InvoiceLineItems{{ItemDescription: "Hosting", Quantity: 12, UnitPrice: 9.95, ItemTaxed: true}},
+			want:  `a:1:{i:0;a:5:{s:4:"desc";s:7:"Hosting";s:3:"qty";d:12;s:2:"up";d:9.95;s:8:"discount";i:0;s:7:"taxable";b:1;}}`,
+		},
+		{
+			name:  "Amount only",
+			items: []InvoiceLineItems{{ItemDescription: "Setup", ItemAmount: 50}, {ItemDescription: "Domain", ItemAmount: 19.5, TaxRate: Float64(10)}},
+			want: `a:2:{i:0;a:5:{s:4:"desc";s:5:"Setup";s:3:"qty";d:1;s:2:"up";d:50;s:8:"discount";i:0;s:7:"taxable";b:0;}` +
+				`i:1;a:5:{s:4:"desc";s:6:"Domain";s:3:"qty";d:1;s:2:"up";d:19.5;s:8:"discount";i:0;s:7:"taxable";b:1;}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := base64.StdEncoding.DecodeString(quoteLineItemsToParam(tt.items))
+			if err != nil {
+				t.Fatalf("quoteLineItemsToParam() is not base64: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("quoteLineItemsToParam() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	parms, _ := QuoteRequest{LineItems: []InvoiceLineItems{{ItemDescription: "Setup", ItemAmount: 50}}}.toParams()
+	if parms["lineitems"] == "" {
+		t.Errorf("QuoteRequest.toParams() lineitems not set, got = %v", parms)
+	}
+}
+
+func TestQuote_InvoiceLineItems(t *testing.T) {
+	var q Quote
+	q.Items.Item = []QuoteItem{
+		{Description: "Hosting", Quantity: "12", UnitPrice: "10.00", Discount: "10", Taxable: true},
+		{Description: "Setup", Quantity: "1", UnitPrice: "50.00", Discount: "0"},
+	}
+
+	got := q.InvoiceLineItems()
+	want := []InvoiceLineItems{
+		{ItemOrder: 1, ItemDescription: "Hosting", Quantity: 12, UnitPrice: 9, ItemTaxed: true},
+		{ItemOrder: 2, ItemDescription: "Setup", Quantity: 1, UnitPrice: 50},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Quote.InvoiceLineItems() got = %+v, want %+v", got, want)
+	}
+	if got[0].Amount() != 108 {
+		t.Errorf("Quote.InvoiceLineItems() Hosting amount = %.2f, want 108.00", got[0].Amount())
+	}
+}
+
+func TestBillingService_quoteActions(t *testing.T) {
+	var form url.Values
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		form = req.PostForm
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"result":"success","quoteid":5,"invoiceid":31}`)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &BillingService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+	valid := QuoteRequest{Subject: "Rebuild", Stage: QuoteDraft, ValidUntil: time.Date(2021, 4, 30, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name    string
+		call    func() (*QuoteReply, *Response, error)
+		action  string
+		quoteID string
+		wantErr bool
+	}{
+		{name: "CreateQuote", call: func() (*QuoteReply, *Response, error) { return s.CreateQuote(valid) }, action: "CreateQuote"},
+		{name: "CreateQuote without valid until", call: func() (*QuoteReply, *Response, error) {
+			return s.CreateQuote(QuoteRequest{Subject: "Rebuild", Stage: QuoteDraft})
+		}, wantErr: true},
+		{name: "CreateQuote unknown stage", call: func() (*QuoteReply, *Response, error) {
+			return s.CreateQuote(QuoteRequest{Subject: "Rebuild", Stage: "Won", ValidUntil: valid.ValidUntil})
+		}, wantErr: true},
+		{name: "UpdateQuote", call: func() (*QuoteReply, *Response, error) { return s.UpdateQuote(5, QuoteRequest{Stage: QuoteLost}) }, action: "UpdateQuote", quoteID: "5"},
+		{name: "UpdateQuote without ID", call: func() (*QuoteReply, *Response, error) { return s.UpdateQuote(0, QuoteRequest{}) }, wantErr: true},
+		{name: "SendQuote", call: func() (*QuoteReply, *Response, error) { return s.SendQuote(5) }, action: "SendQuote", quoteID: "5"},
+		{name: "AcceptQuote", call: func() (*QuoteReply, *Response, error) { return s.AcceptQuote(5) }, action: "AcceptQuote", quoteID: "5"},
+		{name: "DeleteQuote", call: func() (*QuoteReply, *Response, error) { return s.DeleteQuote(5) }, action: "DeleteQuote", quoteID: "5"},
+		{name: "DeleteQuote without ID", call: func() (*QuoteReply, *Response, error) { return s.DeleteQuote(0) }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form = nil
+			got, _, err := tt.call()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if form != nil {
+					t.Errorf("%s sent a request for an invalid quote", tt.name)
+				}
+				return
+			}
+			if form.Get("action") != tt.action || form.Get("quoteid") != tt.quoteID {
+				t.Errorf("%s got params = %v", tt.name, form)
+			}
+			if got.QuoteID != 5 || got.InvoiceID != 31 {
+				t.Errorf("%s got = %+v", tt.name, got)
+			}
+		})
+	}
+}
+
+func TestBillingService_GetQuotes(t *testing.T) {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		body := `{"result":"success","totalresults":1,"startnumber":0,"numreturned":1,"quotes":{"quote":[` +
+			`{"id":5,"subject":"Rebuild","stage":"Delivered","validuntil":"2021-04-30","userid":3,"total":"108.00",` +
+			`"items":{"item":[{"id":1,"quoteid":5,"description":"Hosting","quantity":"12","unitprice":"10.00","discount":"10","taxable":"1"}]}}]}}`
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	s := &BillingService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	got, _, err := s.GetQuotes(map[string]string{"userid": "3"})
+	if err != nil {
+		t.Fatalf("BillingService.GetQuotes() error = %v", err)
+	}
+	if len(got.Quotes.Quote) != 1 {
+		t.Fatalf("BillingService.GetQuotes() got = %v", got)
+	}
+	q := got.Quotes.Quote[0]
+	if q.Stage != QuoteDelivered || q.ValidUntil.Format("2006-01-02") != "2021-04-30" || len(q.Items.Item) != 1 || !bool(q.Items.Item[0].Taxable) {
+		t.Errorf("BillingService.GetQuotes() quote = %+v", q)
+	}
+}
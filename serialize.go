@@ -0,0 +1,85 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// phpPair a key and value of an ordered PHP array
+type phpPair struct {
+	Key   interface{} // int or string
+	Value interface{}
+}
+
+// phpArray an ordered PHP array
+type phpArray []phpPair
+
+// phpSerialize writes v in the format of the PHP serialize function. Supported
+// values are int, float64, string, bool and phpArray.
+func phpSerialize(buf *bytes.Buffer, v interface{}) {
+	switch v := v.(type) {
+	case int:
+		fmt.Fprintf(buf, "i:%d;", v)
+	case float64:
+		fmt.Fprintf(buf, "d:%s;", strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		fmt.Fprintf(buf, "b:%s;", FormatBool(v))
+	case phpArray:
+		fmt.Fprintf(buf, "a:%d:{", len(v))
+		for _, p := range v {
+			phpSerialize(buf, p.Key)
+			phpSerialize(buf, p.Value)
+		}
+		buf.WriteString("}")
+	default:
+		str := fmt.Sprint(v)
+		fmt.Fprintf(buf, "s:%d:\"%s\";", len(str), str)
+	}
+}
+
+// serializeBase64 returns base64_encode(serialize($v)) as the WHMCS API expects
+func serializeBase64(v interface{}) string {
+	var buf bytes.Buffer
+	phpSerialize(&buf, v)
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+/*
+serializeIntMap returns base64_encode(serialize($values)) for a PHP array keyed
+by integer IDs, which is how WHMCS expects customfields and configoptions.
+*/
+func serializeIntMap(values map[int]interface{}) string {
+	keys := make([]int, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	arr := make(phpArray, 0, len(keys))
+	for _, k := range keys {
+		arr = append(arr, phpPair{Key: k, Value: values[k]})
+	}
+
+	return serializeBase64(arr)
+}
+
+// serializeCustomFields encodes custom field ID => value pairs for the WHMCS API
+func serializeCustomFields(fields map[int]string) string {
+	values := make(map[int]interface{}, len(fields))
+	for k, v := range fields {
+		values[k] = v
+	}
+	return serializeIntMap(values)
+}
+
+// serializeConfigOptions encodes configurable option ID => option ID or quantity pairs for the WHMCS API
+func serializeConfigOptions(options map[int]int) string {
+	values := make(map[int]interface{}, len(options))
+	for k, v := range options {
+		values[k] = v
+	}
+	return serializeIntMap(values)
+}
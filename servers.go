@@ -1,9 +1,210 @@
 package whmcsgo
 
-// ServersService handles communication with the ... related
+import (
+	"errors"
+	"fmt"
+)
+
+// ServersService handles communication with the server related
 // methods of the WHMCS API.
 //
 // WHMCS API docs: https://developers.whmcs.com/api/api-index/
 type ServersService struct {
 	client *Client
 }
+
+// Server a provisioning server configured in WHMCS
+type Server struct {
+	ID                 int          `json:"id"`
+	Name               string       `json:"name"`
+	Hostname           string       `json:"hostname"`
+	IPAddress          string       `json:"ipaddress"`
+	Active             bool         `json:"active"`             // Is this the default server for its module
+	ActiveServices     int          `json:"activeServices"`     // The number of active accounts on the server
+	MaxAllowedServices int          `json:"maxAllowedServices"` // The maximum number of accounts allowed
+	PercentUsed        float64      `json:"percentUsed"`
+	Module             string       `json:"module"`
+	Status             ServerStatus `json:"status"` // Only populated when fetchStatus is requested
+}
+
+func (s Server) String() string {
+	return Stringify(s)
+}
+
+// ServerStatus the live status of a server returned when fetchStatus is requested
+type ServerStatus struct {
+	HTTP   bool   `json:"http"`
+	Load   string `json:"load"`
+	Uptime string `json:"uptime"`
+}
+
+// Available reports whether the server can accept another account
+func (s Server) Available() bool {
+	return s.MaxAllowedServices == 0 || s.ActiveServices < s.MaxAllowedServices
+}
+
+// ServersReply object from WHMCS
+type ServersReply struct {
+	Result      string   `json:"result"`
+	Servers     []Server `json:"servers"`
+	FetchStatus bool     `json:"fetchStatus"`
+}
+
+/*
+GetServers Get servers configured in WHMCS
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getservers/
+
+Request Parameters
+
+serviceId
+	int	Obtain the servers for the module of a specific service	Optional
+addonId
+	int	Obtain the servers for the module of a specific addon	Optional
+fetchStatus
+	bool	Fetch the live HTTP status, load and uptime of each server	Optional
+*/
+func (s *ServersService) GetServers(serviceID, addonID int, fetchStatus bool) (*ServersReply, *Response, error) {
+	r := new(ServersReply)
+	parms := map[string]string{"fetchStatus": FormatBool(fetchStatus)}
+	if serviceID > 0 {
+		parms["serviceId"] = fmt.Sprintf("%d", serviceID)
+	}
+	if addonID > 0 {
+		parms["addonId"] = fmt.Sprintf("%d", addonID)
+	}
+
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetServers"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+/*
+ServerGroup a server group configured in WHMCS.
+
+The WHMCS API does not return server groups or the group of a server, so the
+groups are supplied by the caller, for example from configuration matching
+Setup > Products/Services > Servers.
+*/
+type ServerGroup struct {
+	ID        int    // The ID of the server group
+	Name      string // The name of the server group
+	ServerIDs []int  // The servers in the group
+}
+
+// Contains reports whether the server is in the group
+func (g ServerGroup) Contains(serverID int) bool {
+	for _, id := range g.ServerIDs {
+		if id == serverID {
+			return true
+		}
+	}
+	return false
+}
+
+// leastUsed returns the available server matching match with the lowest percentage used, or nil
+func leastUsed(servers []Server, match func(Server) bool) *Server {
+	var best *Server
+	for i := range servers {
+		srv := &servers[i]
+		if !match(*srv) || !srv.Available() {
+			continue
+		}
+		if best == nil || srv.PercentUsed < best.PercentUsed {
+			best = srv
+		}
+	}
+	return best
+}
+
+/*
+LeastUsedServer returns the server for module with the lowest percentage used
+that can still accept another account. The result is suitable for the serverid
+of UpdateClientProduct or AddOrder.
+*/
+func (s *ServersService) LeastUsedServer(module string) (*Server, error) {
+	r, _, err := s.GetServers(0, 0, false)
+	if err != nil {
+		return nil, err
+	}
+
+	best := leastUsed(r.Servers, func(srv Server) bool { return srv.Module == module })
+	if best == nil {
+		return nil, errors.New("no server available for module " + module)
+	}
+	return best, nil
+}
+
+// LeastUsedServerInGroup returns the server in group with the lowest percentage used that can still accept another account
+func (s *ServersService) LeastUsedServerInGroup(group ServerGroup) (*Server, error) {
+	r, _, err := s.GetServers(0, 0, false)
+	if err != nil {
+		return nil, err
+	}
+
+	best := leastUsed(r.Servers, func(srv Server) bool { return group.Contains(srv.ID) })
+	if best == nil {
+		return nil, fmt.Errorf("no server available in group %s", group.Name)
+	}
+	return best, nil
+}
+
+// HealthCheck a single system health check result
+type HealthCheck struct {
+	Name     string `json:"name"`
+	Severity string `json:"severity"`
+	Body     string `json:"body"`
+}
+
+// HealthStatusReply object from WHMCS
+type HealthStatusReply struct {
+	Result string `json:"result"`
+	Checks struct {
+		Success []HealthCheck `json:"success"`
+		Warning []HealthCheck `json:"warning"`
+		Danger  []HealthCheck `json:"danger"`
+		Error   []HealthCheck `json:"error"`
+		Info    []HealthCheck `json:"info"`
+		Notice  []HealthCheck `json:"notice"`
+	} `json:"checks"`
+}
+
+// Healthy reports whether no warning, danger or error checks were returned
+func (h HealthStatusReply) Healthy() bool {
+	return len(h.Checks.Warning) == 0 && len(h.Checks.Danger) == 0 && len(h.Checks.Error) == 0
+}
+
+/*
+GetHealthStatus Get the system health status of the WHMCS installation
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/gethealthstatus/
+
+Request Parameters
+
+fetchStatus
+	bool	Perform the checks that require remote connections	Optional
+*/
+func (s *ServersService) GetHealthStatus(fetchStatus bool) (*HealthStatusReply, *Response, error) {
+	r := new(HealthStatusReply)
+	parms := map[string]string{"fetchStatus": FormatBool(fetchStatus)}
+
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetHealthStatus"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
@@ -0,0 +1,132 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+// serversBody a GetServers reply with two cPanel servers, one full, and a Plesk server
+const serversBody = `{"result":"success","fetchStatus":true,"servers":[` +
+	`{"id":1,"name":"web1","hostname":"web1.example.com","ipaddress":"10.0.0.1","active":true,"activeServices":200,"maxAllowedServices":200,"percentUsed":100,"module":"cpanel",` +
+	`"status":{"http":true,"load":"0.50","uptime":"10 days"}},` +
+	`{"id":2,"name":"web2","hostname":"web2.example.com","ipaddress":"10.0.0.2","active":false,"activeServices":50,"maxAllowedServices":200,"percentUsed":25,"module":"cpanel"},` +
+	`{"id":3,"name":"web3","hostname":"web3.example.com","ipaddress":"10.0.0.3","active":false,"activeServices":120,"maxAllowedServices":200,"percentUsed":60,"module":"cpanel"},` +
+	`{"id":4,"name":"plesk1","hostname":"plesk1.example.com","ipaddress":"10.0.0.4","active":true,"activeServices":1,"maxAllowedServices":100,"percentUsed":1,"module":"plesk"}]}`
+
+func newServersTestService(t *testing.T, want map[string]string, body string) *ServersService {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		req.ParseForm()
+		for k, v := range want {
+			if got := req.PostForm.Get(k); got != v {
+				t.Errorf("%s %s = %q, want %q", req.PostForm.Get("action"), k, got, v)
+			}
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	return &ServersService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+}
+
+func TestServersService_GetServers(t *testing.T) {
+	s := newServersTestService(t, map[string]string{"serviceId": "", "addonId": "9", "fetchStatus": "1"}, serversBody)
+
+	got, _, err := s.GetServers(0, 9, true)
+	if err != nil {
+		t.Fatalf("ServersService.GetServers() error = %v", err)
+	}
+	if len(got.Servers) != 4 || !got.FetchStatus {
+		t.Fatalf("ServersService.GetServers() got = %v", got)
+	}
+
+	web1 := got.Servers[0]
+	if web1.Hostname != "web1.example.com" || !web1.Active || web1.ActiveServices != 200 || web1.MaxAllowedServices != 200 || web1.Available() {
+		t.Errorf("ServersService.GetServers() web1 = %v", web1)
+	}
+	if !web1.Status.HTTP || web1.Status.Load != "0.50" || web1.Status.Uptime != "10 days" {
+		t.Errorf("ServersService.GetServers() web1 status = %v", web1.Status)
+	}
+}
+
+func TestServersService_LeastUsedServer(t *testing.T) {
+	tests := []struct {
+		name    string
+		module  string
+		want    int
+		wantErr bool
+	}{
+		{name: "Skips full servers", module: "cpanel", want: 2},
+		{name: "Other module", module: "plesk", want: 4},
+		{name: "No servers", module: "directadmin", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newServersTestService(t, nil, serversBody)
+
+			got, err := s.LeastUsedServer(tt.module)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ServersService.LeastUsedServer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.ID != tt.want {
+				t.Errorf("ServersService.LeastUsedServer() got = %d, want %d", got.ID, tt.want)
+			}
+		})
+	}
+}
+
+func TestServersService_LeastUsedServerInGroup(t *testing.T) {
+	s := newServersTestService(t, nil, serversBody)
+
+	got, err := s.LeastUsedServerInGroup(ServerGroup{ID: 1, Name: "Shared", ServerIDs: []int{1, 3}})
+	if err != nil {
+		t.Fatalf("ServersService.LeastUsedServerInGroup() error = %v", err)
+	}
+	if got.ID != 3 {
+		t.Errorf("ServersService.LeastUsedServerInGroup() got = %d, want 3", got.ID)
+	}
+
+	if _, err := s.LeastUsedServerInGroup(ServerGroup{Name: "Full", ServerIDs: []int{1}}); err == nil {
+		t.Error("ServersService.LeastUsedServerInGroup() expected an error when every server is full")
+	}
+}
+
+func TestServersService_GetHealthStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantHealthy bool
+		wantSuccess int
+	}{
+		{
+			name: "Healthy",
+			body: `{"result":"success","checks":{"success":[{"name":"PHPVersion","severity":"success","body":"PHP 7.4"}],` +
+				`"info":[{"name":"Cron","severity":"info","body":"Cron ran"}]}}`,
+			wantHealthy: true,
+			wantSuccess: 1,
+		},
+		{
+			name: "Warning",
+			body: `{"result":"success","checks":{"success":[],"warning":[{"name":"SSL","severity":"warning","body":"No SSL"}]}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newServersTestService(t, map[string]string{"fetchStatus": "0"}, tt.body)
+
+			got, _, err := s.GetHealthStatus(false)
+			if err != nil {
+				t.Fatalf("ServersService.GetHealthStatus() error = %v", err)
+			}
+			if got.Healthy() != tt.wantHealthy {
+				t.Errorf("ServersService.GetHealthStatus() Healthy() = %v, want %v, got %+v", got.Healthy(), tt.wantHealthy, got.Checks)
+			}
+			if len(got.Checks.Success) != tt.wantSuccess {
+				t.Errorf("ServersService.GetHealthStatus() got %d success checks, want %d", len(got.Checks.Success), tt.wantSuccess)
+			}
+		})
+	}
+}
@@ -1,9 +1,339 @@
 package whmcsgo
 
-// ServiceService handles communication with the ... related
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ServiceService handles communication with the client service related
 // methods of the WHMCS API.
 //
 // WHMCS API docs: https://developers.whmcs.com/api/api-index/
 type ServiceService struct {
 	client *Client
 }
+
+/*
+GetClientsProducts Obtain a single client service
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getclientsproducts/
+
+Request Parameters
+
+serviceid
+	int	The specific service id to obtain the details for	Required
+*/
+func (s *ServiceService) GetClientsProducts(serviceID int) (*ClientProduct, *Response, error) {
+	if serviceID < 1 {
+		return nil, nil, errors.New("service ID required to get a client product")
+	}
+
+	p := new(ClientsProduct)
+	parms := map[string]string{"serviceid": fmt.Sprintf("%d", serviceID)}
+
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetClientsProducts"}, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, p); err != nil {
+		return nil, resp, err
+	}
+
+	if len(p.Products.Product) == 0 {
+		return nil, resp, fmt.Errorf("service %d not found", serviceID)
+	}
+	return &p.Products.Product[0], resp, nil
+}
+
+// UpgradeRequest the product or configurable option upgrade for a service
+type UpgradeRequest struct {
+	Type                   string      // The type of upgrade: product or configoptions
+	PaymentMethod          string      // The payment method for the upgrade order in system format
+	NewProductID           int         // The new product id, required for a product upgrade
+	NewProductBillingCycle string      // The new billing cycle, required for a product upgrade
+	PromoCode              string      // The promotion code to apply to the upgrade
+	ConfigOptions          map[int]int // Configurable option ID => option ID or quantity for a configoptions upgrade
+}
+
+// UpgradeReply the quote or order created for an upgrade
+type UpgradeReply struct {
+	Result                 string      `json:"result"`
+	OldProductID           json.Number `json:"oldproductid"`
+	OldProductName         string      `json:"oldproductname"`
+	NewProductID           json.Number `json:"newproductid"`
+	NewProductName         string      `json:"newproductname"`
+	DaysUntilRenewal       json.Number `json:"daysuntilrenewal"`
+	TotalDays              json.Number `json:"totaldays"`
+	NewProductBillingCycle string      `json:"newproductbillingcycle"`
+	Price                  string      `json:"price"`
+	Discount               string      `json:"discount"`
+	PromoType              string      `json:"promotype"`
+	PromoValue             string      `json:"promovalue"`
+	OrderID                int         `json:"orderid"`   // Not set when calconly is requested
+	InvoiceID              int         `json:"invoiceid"` // Not set when calconly is requested
+}
+
+/*
+UpgradeProduct Upgrade, or calculate an upgrade on, a product
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/upgradeproduct/
+
+Request Parameters
+
+serviceid
+	int	The ID of the service to update	Required
+calconly
+	bool	Only calculate the upgrade amount, nothing is created	Optional
+paymentmethod
+	string	The payment method of the order in system format	Required
+type
+	string	The type of upgrade: product or configoptions	Required
+newproductid
+	int	The new product id to upgrade to	Optional
+newproductbillingcycle
+	string	The new product billing cycle	Optional
+promocode
+	string	The promotion code to apply to the upgrade	Optional
+configoptions[]
+	array	An array of config options to upgrade	Optional
+*/
+func (s *ServiceService) UpgradeProduct(serviceID int, upgrade UpgradeRequest, calcOnly bool) (*UpgradeReply, *Response, error) {
+	if serviceID < 1 {
+		return nil, nil, errors.New("service ID required to upgrade a product")
+	}
+
+	parms := map[string]string{
+		"serviceid": fmt.Sprintf("%d", serviceID),
+		"calconly":  FormatBool(calcOnly),
+	}
+
+	switch upgrade.Type {
+	case "product":
+		if upgrade.NewProductID < 1 || len(upgrade.NewProductBillingCycle) == 0 {
+			return nil, nil, errors.New("new product ID and billing cycle required for a product upgrade")
+		}
+		parms["newproductid"] = fmt.Sprintf("%d", upgrade.NewProductID)
+		parms["newproductbillingcycle"] = upgrade.NewProductBillingCycle
+	case "configoptions":
+		if len(upgrade.ConfigOptions) == 0 {
+			return nil, nil, errors.New("config options required for a configoptions upgrade")
+		}
+		for id, v := range upgrade.ConfigOptions {
+			parms[fmt.Sprintf("configoptions[%d]", id)] = fmt.Sprintf("%d", v)
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported upgrade type: %s", upgrade.Type)
+	}
+	parms["type"] = upgrade.Type
+
+	if len(upgrade.PaymentMethod) > 0 {
+		parms["paymentmethod"] = upgrade.PaymentMethod
+	}
+	if len(upgrade.PromoCode) > 0 {
+		parms["promocode"] = upgrade.PromoCode
+	}
+
+	r := new(UpgradeReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "UpgradeProduct"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// Cancellation types accepted by AddCancelRequest
+const (
+	CancelImmediate          = "Immediate"
+	CancelEndOfBillingPeriod = "End of Billing Period"
+)
+
+// CancelRequestReply the status after adding a cancellation request
+type CancelRequestReply struct {
+	Result    string      `json:"result"`
+	ServiceID json.Number `json:"serviceid"`
+	UserID    json.Number `json:"userid"`
+}
+
+/*
+AddCancelRequest Adds a cancellation request
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/addcancelrequest/
+
+Request Parameters
+
+serviceid
+	int	The Service ID to cancel	Required
+type
+	string	The type of cancellation: Immediate or End of Billing Period	Optional
+reason
+	string	The customer reason for cancellation	Optional
+*/
+func (s *ServiceService) AddCancelRequest(serviceID int, cancelType, reason string) (*CancelRequestReply, *Response, error) {
+	if serviceID < 1 {
+		return nil, nil, errors.New("service ID required to add a cancel request")
+	}
+
+	parms := map[string]string{"serviceid": fmt.Sprintf("%d", serviceID)}
+
+	switch cancelType {
+	case "":
+	case CancelImmediate, CancelEndOfBillingPeriod:
+		parms["type"] = cancelType
+	default:
+		return nil, nil, fmt.Errorf("unsupported cancellation type: %s", cancelType)
+	}
+
+	if len(reason) > 0 {
+		parms["reason"] = reason
+	}
+
+	r := new(CancelRequestReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "AddCancelRequest"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// CancelledPackage a cancellation request for a service
+type CancelledPackage struct {
+	ID     int       `json:"id"`
+	RelID  int       `json:"relid"` // The service ID
+	Reason string    `json:"reason"`
+	Type   string    `json:"type"`
+	Date   WHCMSdate `json:"date"`
+}
+
+// CancelledPackagesReply object from WHMCS
+type CancelledPackagesReply struct {
+	Packages struct {
+		Package []CancelledPackage `json:"package"`
+	} `json:"packages"`
+	Numreturned  int    `json:"numreturned"`
+	Result       string `json:"result"`
+	Startnumber  int    `json:"startnumber"`
+	Totalresults int    `json:"totalresults"`
+}
+
+/*
+GetCancelledPackages Obtain a list of cancellation requests
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getcancelledpackages/
+
+Request Parameters
+
+limitstart
+	int	The offset for the returned data (default: 0)	Optional
+limitnum
+	int	The number of records to return (default: 25)	Optional
+*/
+func (s *ServiceService) GetCancelledPackages(parms map[string]string) (*CancelledPackagesReply, *Response, error) {
+	r := new(CancelledPackagesReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetCancelledPackages"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// moduleAction runs a provisioning module command against a service
+func (s *ServiceService) moduleAction(action string, serviceID int, parms map[string]string) (*ActionReply, *Response, error) {
+	if serviceID < 1 {
+		return nil, nil, fmt.Errorf("service ID required to run %s", action)
+	}
+
+	parms["serviceid"] = fmt.Sprintf("%d", serviceID)
+
+	r := new(ActionReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: action}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// ModuleCreate Runs the module create action for a service
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/modulecreate/
+func (s *ServiceService) ModuleCreate(serviceID int) (*ActionReply, *Response, error) {
+	return s.moduleAction("ModuleCreate", serviceID, map[string]string{})
+}
+
+// ModuleSuspend Runs the module suspend action for a service
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/modulesuspend/
+func (s *ServiceService) ModuleSuspend(serviceID int, reason string) (*ActionReply, *Response, error) {
+	parms := map[string]string{}
+	if len(reason) > 0 {
+		parms["suspendreason"] = reason
+	}
+	return s.moduleAction("ModuleSuspend", serviceID, parms)
+}
+
+// ModuleUnsuspend Runs the module unsuspend action for a service
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/moduleunsuspend/
+func (s *ServiceService) ModuleUnsuspend(serviceID int) (*ActionReply, *Response, error) {
+	return s.moduleAction("ModuleUnsuspend", serviceID, map[string]string{})
+}
+
+// ModuleTerminate Runs the module terminate action for a service
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/moduleterminate/
+func (s *ServiceService) ModuleTerminate(serviceID int) (*ActionReply, *Response, error) {
+	return s.moduleAction("ModuleTerminate", serviceID, map[string]string{})
+}
+
+// ModuleChangePackage Runs the module change package action for a service
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/modulechangepackage/
+func (s *ServiceService) ModuleChangePackage(serviceID int) (*ActionReply, *Response, error) {
+	return s.moduleAction("ModuleChangePackage", serviceID, map[string]string{})
+}
+
+// ModuleChangePw Runs the module change password action for a service
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/modulechangepw/
+func (s *ServiceService) ModuleChangePw(serviceID int, password string) (*ActionReply, *Response, error) {
+	parms := map[string]string{}
+	if len(password) > 0 {
+		parms["servicepassword"] = password
+	}
+	return s.moduleAction("ModuleChangePw", serviceID, parms)
+}
+
+// ModuleCustom Runs a custom module function for a service
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/modulecustom/
+func (s *ServiceService) ModuleCustom(serviceID int, funcName string) (*ActionReply, *Response, error) {
+	if len(funcName) == 0 {
+		return nil, nil, errors.New("function name required to run ModuleCustom")
+	}
+	return s.moduleAction("ModuleCustom", serviceID, map[string]string{"func_name": funcName})
+}
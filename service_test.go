@@ -0,0 +1,38 @@
+package whmcsgo
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+)
+
+func TestServiceUpdate_toParams(t *testing.T) {
+	update := ServiceUpdate{
+		ServerID:           Int(4),
+		Status:             String("Suspended"),
+		OverideAutoSuspend: Bool(true),
+		Unset:              []string{"notes"},
+		CustomFields:       map[int]string{3: "Yahoo", 1: "ab"},
+	}
+
+	got := update.toParams()
+
+	cf, err := base64.StdEncoding.DecodeString(got["customfields"])
+	if err != nil {
+		t.Fatalf("customfields is not base64: %v", err)
+	}
+	if string(cf) != `a:2:{i:1;s:2:"ab";i:3;s:5:"Yahoo";}` {
+		t.Errorf("customfields got = %s", cf)
+	}
+	delete(got, "customfields")
+
+	want := map[string]string{
+		"serverid":           "4",
+		"status":             "Suspended",
+		"overideautosuspend": "on",
+		"unset[0]":           "notes",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ServiceUpdate.toParams() got = %v, want %v", got, want)
+	}
+}
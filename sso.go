@@ -0,0 +1,192 @@
+package whmcsgo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ssoTokenLifetime how long WHMCS accepts a single sign-on token after it is created
+const ssoTokenLifetime = 60 * time.Second
+
+// Client area destinations of a single sign-on token
+const (
+	SsoHomepage       = "clientarea:homepage"
+	SsoProfile        = "clientarea:profile"
+	SsoBillingInfo    = "clientarea:billing_info"
+	SsoContacts       = "clientarea:contacts"
+	SsoServices       = "clientarea:services"
+	SsoProductDetails = "clientarea:product_details" // Requires ServiceID
+	SsoDomains        = "clientarea:domains"
+	SsoDomainDetails  = "clientarea:domain_details" // Requires DomainID
+	SsoInvoices       = "clientarea:invoices"
+	SsoTickets        = "clientarea:tickets"
+	SsoEmails         = "clientarea:emails"
+	SsoCustomRedirect = "sso:custom_redirect" // Requires RedirectPath
+)
+
+// SsoTokenRequest who the token logs in and where they land, an empty destination opens the client area homepage
+type SsoTokenRequest struct {
+	ClientID     int    // The client to log in to
+	UserID       int    // The user to log in as
+	Destination  string // The client area page to open, see Sso consts
+	ServiceID    int    // The service to open with SsoProductDetails
+	DomainID     int    // The domain to open with SsoDomainDetails
+	InvoiceID    int    // The invoice to open, sets the destination to SsoCustomRedirect
+	RedirectPath string // The path to open with SsoCustomRedirect, relative to the WHMCS system URL
+}
+
+func (r SsoTokenRequest) toParams() (map[string]string, error) {
+	if r.ClientID < 1 && r.UserID < 1 {
+		return nil, errors.New("client ID or user ID required to create a single sign-on token")
+	}
+
+	parms := map[string]string{}
+	if r.ClientID > 0 {
+		parms["client_id"] = fmt.Sprintf("%d", r.ClientID)
+	}
+	if r.UserID > 0 {
+		parms["user_id"] = fmt.Sprintf("%d", r.UserID)
+	}
+
+	destination, path := r.Destination, r.RedirectPath
+	if r.InvoiceID > 0 {
+		if (destination != "" && destination != SsoCustomRedirect) || path != "" {
+			return nil, errors.New("an invoice can not be opened with another destination or redirect path")
+		}
+		destination = SsoCustomRedirect
+		path = fmt.Sprintf("viewinvoice.php?id=%d", r.InvoiceID)
+	}
+
+	switch destination {
+	case "":
+	case SsoProductDetails:
+		if r.ServiceID < 1 {
+			return nil, errors.New("service ID required to open the product details")
+		}
+		parms["service_id"] = fmt.Sprintf("%d", r.ServiceID)
+	case SsoDomainDetails:
+		if r.DomainID < 1 {
+			return nil, errors.New("domain ID required to open the domain details")
+		}
+		parms["domain_id"] = fmt.Sprintf("%d", r.DomainID)
+	case SsoCustomRedirect:
+		if path == "" {
+			return nil, errors.New("redirect path required for a custom redirect")
+		}
+		parms["sso_redirect_path"] = strings.TrimPrefix(path, "/")
+	default:
+		if !strings.HasPrefix(destination, "clientarea:") {
+			return nil, fmt.Errorf("unsupported single sign-on destination: %s", destination)
+		}
+	}
+	if destination != "" {
+		parms["destination"] = destination
+	}
+
+	return parms, nil
+}
+
+// SsoToken a single sign-on token, the user is logged in by opening RedirectURL before Expires
+type SsoToken struct {
+	Result      string    `json:"result"`
+	Message     string    `json:"message"`
+	AccessToken string    `json:"access_token"`
+	RedirectURL string    `json:"redirect_url"`
+	Expires     time.Time `json:"-"`
+}
+
+// Expired reports whether the token can no longer be used at now
+func (t SsoToken) Expired(now time.Time) bool {
+	return !now.Before(t.Expires)
+}
+
+/*
+CreateSsoToken Create a single use client area login token. Tokens can only be
+used once and expire 60 seconds after they are created.
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/createssotoken/
+
+Request Parameters
+
+client_id
+	int	The ID of the client to create the token for	Optional
+user_id
+	int	The ID of the user to create the token for	Optional
+destination
+	string	The client area destination, eg clientarea:product_details	Optional
+service_id
+	int	The service ID for clientarea:product_details	Optional
+domain_id
+	int	The domain ID for clientarea:domain_details	Optional
+sso_redirect_path
+	string	The path to redirect to for sso:custom_redirect	Optional
+*/
+func (s *AccountsService) CreateSsoToken(token SsoTokenRequest) (*SsoToken, *Response, error) {
+	parms, err := token.toParams()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := time.Now()
+	r := new(SsoToken)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "CreateSsoToken"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	r.Expires = created.Add(ssoTokenLifetime)
+	return r, resp, nil
+}
+
+// ValidateLoginReply the user of valid login details
+type ValidateLoginReply struct {
+	Result           string    `json:"result"`
+	Message          string    `json:"message"`
+	UserID           WHMCSint  `json:"userid"`
+	PasswordHash     string    `json:"passwordhash"`
+	TwoFactorEnabled WHMCSbool `json:"twoFactorEnabled"`
+}
+
+/*
+ValidateLogin Validate user login credentials. Invalid credentials are returned
+as an error.
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/validatelogin/
+
+Request Parameters
+
+email
+	string	The email address of the user	Required
+password2
+	string	The password of the user	Required
+*/
+func (s *AccountsService) ValidateLogin(email, password string) (*ValidateLoginReply, *Response, error) {
+	if email == "" || password == "" {
+		return nil, nil, errors.New("email and password required to validate a login")
+	}
+
+	parms := map[string]string{
+		"email":     email,
+		"password2": password,
+	}
+
+	r := new(ValidateLoginReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "ValidateLogin"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
@@ -0,0 +1,144 @@
+package whmcsgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSsoTokenRequest_toParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     SsoTokenRequest
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "Client area",
+			req:  SsoTokenRequest{ClientID: 3},
+			want: map[string]string{"client_id": "3"},
+		},
+		{
+			name: "Service",
+			req:  SsoTokenRequest{UserID: 5, Destination: SsoProductDetails, ServiceID: 11},
+			want: map[string]string{"user_id": "5", "destination": "clientarea:product_details", "service_id": "11"},
+		},
+		{
+			name: "Domain",
+			req:  SsoTokenRequest{ClientID: 3, Destination: SsoDomainDetails, DomainID: 21},
+			want: map[string]string{"client_id": "3", "destination": "clientarea:domain_details", "domain_id": "21"},
+		},
+		{
+			name: "Invoice",
+			req:  SsoTokenRequest{ClientID: 3, InvoiceID: 31},
+			want: map[string]string{"client_id": "3", "destination": "sso:custom_redirect", "sso_redirect_path": "viewinvoice.php?id=31"},
+		},
+		{
+			name:    "No client or user",
+			req:     SsoTokenRequest{Destination: SsoInvoices},
+			wantErr: true,
+		},
+		{
+			name:    "Service without ID",
+			req:     SsoTokenRequest{ClientID: 3, Destination: SsoProductDetails},
+			wantErr: true,
+		},
+		{
+			name:    "Invoice with another destination",
+			req:     SsoTokenRequest{ClientID: 3, Destination: SsoServices, InvoiceID: 31},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown destination",
+			req:     SsoTokenRequest{ClientID: 3, Destination: "admin:clients"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.req.toParams()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SsoTokenRequest.toParams() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("SsoTokenRequest.toParams() got = %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("SsoTokenRequest.toParams() %s = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestAccountsService_CreateSsoToken(t *testing.T) {
+	tclient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{"result":"success","access_token":"abc123",` +
+				`"redirect_url":"https:\/\/example.com\/oauth\/singlesignon.php?access_token=abc123"}`)),
+			Header: make(http.Header),
+		}
+	})
+
+	s := &AccountsService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+	before := time.Now()
+	got, _, err := s.CreateSsoToken(SsoTokenRequest{ClientID: 3})
+	if err != nil {
+		t.Fatalf("AccountsService.CreateSsoToken() error = %v", err)
+	}
+	if got.RedirectURL != "https://example.com/oauth/singlesignon.php?access_token=abc123" {
+		t.Errorf("AccountsService.CreateSsoToken() RedirectURL = %s", got.RedirectURL)
+	}
+	if got.Expires.Before(before.Add(ssoTokenLifetime)) || got.Expired(before) || !got.Expired(got.Expires) {
+		t.Errorf("AccountsService.CreateSsoToken() Expires = %v", got.Expires)
+	}
+}
+
+func TestAccountsService_ValidateLogin(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Valid",
+			body: `{"result":"success","userid":"5","passwordhash":"hash","twoFactorEnabled":false}`,
+			want: 5,
+		},
+		{
+			name:    "Invalid",
+			body:    `{"result":"error","message":"Email or Password Invalid"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tclient := NewTestClient(func(req *http.Request) *http.Response {
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(tt.body)),
+					Header:     make(http.Header),
+				}
+			})
+
+			s := &AccountsService{client: NewClient(tclient, Authentication{}, "defaultBaseURL string")}
+
+			got, _, err := s.ValidateLogin("jo@example.com", "secret")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AccountsService.ValidateLogin() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && int(got.UserID) != tt.want {
+				t.Errorf("AccountsService.ValidateLogin() UserID = %d, want %d", got.UserID, tt.want)
+			}
+		})
+	}
+}
@@ -47,7 +47,8 @@ func (wd *WHCMSdate) UnmarshalJSON(input []byte) error {
 	strInput = strings.Trim(strInput, `"`)
 
 	layout := "2006-01-02 -0700 MST"
-	if strInput == "0000-00-00 00:00:00" {
+	switch strInput {
+	case "", "null", "0000-00-00", "0000-00-00 00:00:00":
 		wd.Time = time.Time{}
 		return nil
 	}
@@ -65,3 +66,38 @@ func (wd *WHCMSdate) UnmarshalJSON(input []byte) error {
 	wd.Time = newTime
 	return nil
 }
+
+// WHMCSbool allows the JSON boolean, number or string flags returned by
+// WHMCS ("1", "on", true, 1) to be Unmarshaled
+type WHMCSbool bool
+
+// UnmarshalJSON interface, we need a function UnmarshalJSON on the WHMCSbool type.
+func (b *WHMCSbool) UnmarshalJSON(input []byte) error {
+	switch strings.ToLower(strings.Trim(string(input), `"`)) {
+	case "1", "true", "on", "yes":
+		*b = true
+	default:
+		*b = false
+	}
+	return nil
+}
+
+// WHMCSint allows the JSON numbers and numeric strings returned by WHMCS to be
+// Unmarshaled, an empty string or null is zero
+type WHMCSint int
+
+// UnmarshalJSON interface, we need a function UnmarshalJSON on the WHMCSint type.
+func (i *WHMCSint) UnmarshalJSON(input []byte) error {
+	s := strings.TrimSpace(strings.Trim(string(input), `"`))
+	if s == "" || s == "null" {
+		*i = 0
+		return nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	*i = WHMCSint(n)
+	return nil
+}
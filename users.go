@@ -0,0 +1,315 @@
+package whmcsgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Client permissions granted to users and sub-accounts
+const (
+	ClientPermissionProfile        = "profile"
+	ClientPermissionContacts       = "contacts"
+	ClientPermissionProducts       = "products"
+	ClientPermissionManageProducts = "manageproducts"
+	ClientPermissionProductsSso    = "productsso"
+	ClientPermissionDomains        = "domains"
+	ClientPermissionManageDomains  = "managedomains"
+	ClientPermissionInvoices       = "invoices"
+	ClientPermissionQuotes         = "quotes"
+	ClientPermissionTickets        = "tickets"
+	ClientPermissionAffiliates     = "affiliates"
+	ClientPermissionEmails         = "emails"
+	ClientPermissionOrders         = "orders"
+)
+
+// User a WHMCS 8 user login, a user can have access to many clients
+type User struct {
+	ID             int          `json:"id"`
+	FirstName      string       `json:"firstname"`
+	LastName       string       `json:"lastname"`
+	Email          string       `json:"email"`
+	DateCreated    WHCMSdate    `json:"datecreated"`
+	ValidationData string       `json:"validationdata"`
+	Clients        []UserClient `json:"clients"`
+}
+
+func (u User) String() string {
+	return Stringify(u)
+}
+
+// UserClient a client the user has access to
+type UserClient struct {
+	ID      int       `json:"id"`
+	IsOwner WHMCSbool `json:"isOwner"`
+}
+
+// UsersReply object from WHMCS
+type UsersReply struct {
+	Result       string `json:"result"`
+	Totalresults int    `json:"totalresults"`
+	Startnumber  int    `json:"startnumber"`
+	Numreturned  int    `json:"numreturned"`
+	Users        []User `json:"users"`
+}
+
+// UserReply the status after a user action
+type UserReply struct {
+	Result  string `json:"result"`
+	Message string `json:"message"`
+	UserID  int    `json:"user_id"`
+}
+
+// UserPermissions the permissions a user has on a client, WHMCS may send them as a list or a comma separated string
+type UserPermissions []string
+
+// UnmarshalJSON decodes a list or comma separated string of permissions
+func (p *UserPermissions) UnmarshalJSON(input []byte) error {
+	if isEmptyJSON(input) {
+		*p = nil
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(input, &list); err == nil {
+		*p = list
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(input, &str); err != nil {
+		return err
+	}
+	*p = strings.Split(str, ",")
+	return nil
+}
+
+// UserPermissionsReply object from WHMCS
+type UserPermissionsReply struct {
+	Result      string          `json:"result"`
+	Permissions UserPermissions `json:"permissions"`
+}
+
+// UserRequest the user details to add or update, only set fields are sent
+type UserRequest struct {
+	FirstName *string
+	LastName  *string
+	Email     *string
+	Password  *string // Only used when adding a user
+	Language  *string
+}
+
+func (u UserRequest) toParams() map[string]string {
+	parms := map[string]string{}
+	if u.FirstName != nil {
+		parms["firstname"] = *u.FirstName
+	}
+	if u.LastName != nil {
+		parms["lastname"] = *u.LastName
+	}
+	if u.Email != nil {
+		parms["email"] = *u.Email
+	}
+	if u.Password != nil {
+		parms["password2"] = *u.Password
+	}
+	if u.Language != nil {
+		parms["language"] = *u.Language
+	}
+	return parms
+}
+
+/*
+AddUser Adds a user
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/adduser/
+
+Request Parameters
+
+firstname
+	string	The first name of the user	Required
+lastname
+	string	The last name of the user	Required
+email
+	string	The email address of the user	Required
+password2
+	string	The password for the user	Required
+language
+	string	The language of the user	Optional
+*/
+func (s *AccountsService) AddUser(user UserRequest) (*UserReply, *Response, error) {
+	if user.FirstName == nil || user.LastName == nil || user.Email == nil || user.Password == nil {
+		return nil, nil, errors.New("first name, last name, email and password required to add a user")
+	}
+	return s.userAction("AddUser", user.toParams())
+}
+
+/*
+GetUsers Obtain the users that match passed criteria
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/getusers/
+
+Request Parameters
+
+limitstart
+	int	The offset for the returned user data (default: 0)	Optional
+limitnum
+	int	The number of records to return (default: 25)	Optional
+sorting
+	string	The direction to sort the results: ASC or DESC	Optional
+search
+	string	The search term to look for at the start of email, firstname or lastname	Optional
+*/
+func (s *AccountsService) GetUsers(parms map[string]string) (*UsersReply, *Response, error) {
+	r := new(UsersReply)
+	resp, err := apiRequest(s.client, Params{parms: copyParms(parms), u: "GetUsers"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// UpdateUser Updates a user, only the set fields of user are changed
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/updateuser/
+func (s *AccountsService) UpdateUser(userID int, user UserRequest) (*UserReply, *Response, error) {
+	if userID < 1 {
+		return nil, nil, errors.New("user ID required to update a user")
+	}
+
+	parms := user.toParams()
+	delete(parms, "password2")
+	parms["user_id"] = fmt.Sprintf("%d", userID)
+
+	return s.userAction("UpdateUser", parms)
+}
+
+/*
+CreateClientInvite Sends an invite to manage a client account
+
+WHMCS API docs
+
+https://developers.whmcs.com/api-reference/createclientinvite/
+
+Request Parameters
+
+client_id
+	int	The ID of the client the user is invited to	Required
+email
+	string	The email address to send the invite to	Required
+permissions
+	string	A comma separated list of permissions to grant	Optional
+*/
+func (s *AccountsService) CreateClientInvite(clientID int, email string, permissions []string) (*UserReply, *Response, error) {
+	if clientID < 1 || len(email) == 0 {
+		return nil, nil, errors.New("client ID and email required to create a client invite")
+	}
+
+	parms := map[string]string{
+		"client_id": fmt.Sprintf("%d", clientID),
+		"email":     email,
+	}
+	if len(permissions) > 0 {
+		parms["permissions"] = strings.Join(permissions, ",")
+	}
+
+	return s.userAction("CreateClientInvite", parms)
+}
+
+// GetUserPermissions Obtain the permissions a user has on a client
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/getuserpermissions/
+func (s *AccountsService) GetUserPermissions(userID, clientID int) (*UserPermissionsReply, *Response, error) {
+	if userID < 1 || clientID < 1 {
+		return nil, nil, errors.New("user ID and client ID required to get user permissions")
+	}
+
+	parms := map[string]string{
+		"user_id":   fmt.Sprintf("%d", userID),
+		"client_id": fmt.Sprintf("%d", clientID),
+	}
+
+	r := new(UserPermissionsReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: "GetUserPermissions"}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// UpdateUserPermissions Replaces the permissions a user has on a client
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/updateuserpermissions/
+func (s *AccountsService) UpdateUserPermissions(userID, clientID int, permissions []string) (*UserReply, *Response, error) {
+	if userID < 1 || clientID < 1 {
+		return nil, nil, errors.New("user ID and client ID required to update user permissions")
+	}
+
+	parms := map[string]string{
+		"user_id":     fmt.Sprintf("%d", userID),
+		"client_id":   fmt.Sprintf("%d", clientID),
+		"permissions": strings.Join(permissions, ","),
+	}
+
+	return s.userAction("UpdateUserPermissions", parms)
+}
+
+// ResetPassword Starts the password reset process for a user, identified by ID or email
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/resetpassword/
+func (s *AccountsService) ResetPassword(userID int, email string) (*UserReply, *Response, error) {
+	parms := map[string]string{}
+	switch {
+	case userID > 0:
+		parms["id"] = fmt.Sprintf("%d", userID)
+	case len(email) > 0:
+		parms["email"] = email
+	default:
+		return nil, nil, errors.New("user ID or email required to reset a password")
+	}
+
+	return s.userAction("ResetPassword", parms)
+}
+
+// DeleteUserClient Removes the access a user has to a client
+//
+// WHMCS API docs: https://developers.whmcs.com/api-reference/deleteuserclient/
+func (s *AccountsService) DeleteUserClient(userID, clientID int) (*UserReply, *Response, error) {
+	if userID < 1 || clientID < 1 {
+		return nil, nil, errors.New("user ID and client ID required to delete a user client")
+	}
+
+	parms := map[string]string{
+		"user_id":   fmt.Sprintf("%d", userID),
+		"client_id": fmt.Sprintf("%d", clientID),
+	}
+
+	return s.userAction("DeleteUserClient", parms)
+}
+
+// userAction sends a user action and decodes the reply
+func (s *AccountsService) userAction(action string, parms map[string]string) (*UserReply, *Response, error) {
+	r := new(UserReply)
+	resp, err := apiRequest(s.client, Params{parms: parms, u: action}, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err = decodeResponse(resp, r); err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
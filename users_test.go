@@ -0,0 +1,30 @@
+package whmcsgo
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestUserPermissions_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  UserPermissions
+	}{
+		{name: "List", input: `["profile","invoices"]`, want: UserPermissions{"profile", "invoices"}},
+		{name: "String", input: `"profile,invoices"`, want: UserPermissions{"profile", "invoices"}},
+		{name: "Empty", input: `""`, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got UserPermissions
+			if err := json.Unmarshal([]byte(tt.input), &got); err != nil {
+				t.Fatalf("UserPermissions.UnmarshalJSON() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UserPermissions.UnmarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -113,18 +113,17 @@ func createTestClient(whmcs *Client) (*Account, error) {
 }
 
 // Adds and accepts an order
-func createTestOrder(whmcs *Client, clientID, productID int, paymentMethod string) (*Order, error) {
+func createTestOrder(whmcs *Client, clientID, productID int, paymentMethod string) (*OrderReply, error) {
 	// Add the order
-	order, resp, err := whmcs.Orders.AddOrder(map[string]string{
-		"clientid": fmt.Sprintf("%d", clientID), "paymentmethod": paymentMethod,
-		"pid": fmt.Sprintf("1, %d", productID),
+	order, resp, err := whmcs.Orders.AddOrder(AddOrderRequest{
+		ClientID: clientID, PaymentMethod: paymentMethod,
+		Products: []OrderProduct{{PID: 1}, {PID: productID}},
 	})
 	if err != nil {
 		return nil, err
 	} else if resp.StatusCode != 200 && resp.StatusCode != 201 {
 		return nil, fmt.Errorf("error, AddOrder returned status of: %s", resp.Status)
 	}
-	json.Unmarshal([]byte(resp.Body), order)
 
 	// Accept the order
 	_, err = whmcs.Orders.AcceptOrder(map[string]string{
@@ -258,6 +257,7 @@ func TestCreateInvoice(t *testing.T) {
 
 	// Create a new Invoice
 	invoice := CreateInvoiceRequest{}
+	invoice.UserID = client.ID
 	invoice.SendInvoice = false
 	invoice.Status = "Draft"
 	invoice.DueDate = now.EndOfMonth()
@@ -293,7 +293,7 @@ func TestCreateInvoice(t *testing.T) {
 		return true
 	}
 
-	invoiceid, supportInvoice, err := whmcs.Billing.CreateInvoice(client.ID, invoice)
+	invoiceid, supportInvoice, err := whmcs.Billing.CreateInvoice(invoice)
 
 	assert.NoError(t, err)
 
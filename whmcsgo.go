@@ -1,7 +1,9 @@
 package whmcsgo
 
 import (
+	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -129,6 +131,8 @@ type WRequest struct {
 	data *url.Values
 
 	url *url.URL
+
+	ctx context.Context // Cancels the request, none when nil
 }
 
 // Params specifies the optional parameters to various List methods that
@@ -201,7 +205,17 @@ func newResponse(r *http.Response) *Response {
 func (c *Client) Do(req WRequest, v interface{}) (*Response, error) {
 
 	// 	fmt.Println("--- " + req.url.String())
-	resp, err := c.client.PostForm(req.url.String(), *req.data)
+	ctx := req.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	hreq, err := http.NewRequestWithContext(ctx, "POST", req.url.String(), strings.NewReader(req.data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	hreq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(hreq)
 	if err != nil {
 		return nil, err
 	}
@@ -219,10 +233,16 @@ func (c *Client) Do(req WRequest, v interface{}) (*Response, error) {
 }
 
 func apiRequest(c *Client, p Params, a interface{}) (*Response, error) {
+	return apiRequestContext(context.Background(), c, p, a)
+}
+
+// apiRequestContext sends the request like apiRequest, ctx cancels the request
+func apiRequestContext(ctx context.Context, c *Client, p Params, a interface{}) (*Response, error) {
 	req, err := c.NewRequest(p.parms, p.u)
 	if err != nil {
 		return nil, err
 	}
+	req.ctx = ctx
 
 	resp, err := c.Do(*req, a)
 	if err != nil {
@@ -246,6 +266,72 @@ func apiRequest(c *Client, p Params, a interface{}) (*Response, error) {
 	return resp, err
 }
 
+// ActionReply is returned by WHMCS API actions that only report whether
+// the operation succeeded.
+type ActionReply struct {
+	Result  string `json:"result"`  // The result of the operation: success or error
+	Message string `json:"message"` // The error message, if any
+}
+
+// decodeResponse unmarshals the body of a WHMCS API response into v and
+// converts a result of "error" into a Go error.
+func decodeResponse(resp *Response, v interface{}) error {
+	reply := ActionReply{}
+	if err := json.Unmarshal([]byte(resp.Body), &reply); err != nil {
+		return fmt.Errorf("json.Unmarshal failed : %w", err)
+	}
+
+	if reply.Result == "error" {
+		return fmt.Errorf("whmcs error : %s", reply.Message)
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal([]byte(resp.Body), v); err != nil {
+		return fmt.Errorf("json.Unmarshal failed : %w", err)
+	}
+
+	return nil
+}
+
+// defaultPageSize is the number of records requested per page when walking
+// a WHMCS list action.
+const defaultPageSize = 250
+
+// paginate walks a WHMCS list action page by page. fetch is called with the
+// limitstart and limitnum to request and returns the number of records
+// returned and the total number of records available.
+func paginate(pageSize int, fetch func(start, num int) (int, int, error)) error {
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+
+	start := 0
+	for {
+		returned, total, err := fetch(start, pageSize)
+		if err != nil {
+			return err
+		}
+
+		start += returned
+		if returned == 0 || start >= total {
+			return nil
+		}
+	}
+}
+
+// copyParms returns a copy of parms that can be modified without touching the
+// callers map.
+func copyParms(parms map[string]string) map[string]string {
+	c := make(map[string]string, len(parms))
+	for k, v := range parms {
+		c[k] = v
+	}
+	return c
+}
+
 /*
 ErrorResponse reports one or more errors caused by an API request.
 */
@@ -347,6 +433,14 @@ func Int(v int) *int {
 	return p
 }
 
+// Float64 is a helper routine that allocates a new float64 value
+// to store v and returns a pointer to it.
+func Float64(v float64) *float64 {
+	p := new(float64)
+	*p = v
+	return p
+}
+
 // String is a helper routine that allocates a new string value
 // to store v and returns a pointer to it.
 func String(v string) *string {